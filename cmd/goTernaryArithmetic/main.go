@@ -0,0 +1,307 @@
+/**
+ * Filename: 	main.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 28.08.2021
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+// Cross Platform Compilation for ARMv7
+// env CC=arm-linux-gnueabi-gcc GOOS=linux GOARCH=arm GOARM=7 CGO_ENABLED=1 go build --ldflags '-linkmode external -extldflags "-static"' ./cmd/goTernaryArithmetic
+//
+
+package main
+
+/*
+#cgo CFLAGS: -g -Wall
+#cgo LDFLAGS: -lm
+#include <stdio.h>
+#include <stdlib.h>
+#include <math.h>
+#include "./trslib.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/askfind/goTernaryArithmetic/setun"
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+// ***************************************************************************
+// Виртуальный процессор: TRISC-32
+// Автор: @oberon87
+//
+// Links:
+// 1) https://habr.com/ru/users/oberon87/
+// 2) https://people.inf.ethz.ch/wirth/FPGA-relatedWork/RISC-Arch.pdf
+// 3) https://people.inf.ethz.ch/wirth/ProjectOberon/PO.Computer.pdf
+// 4) https://habr.com/ru/post/258727/
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------
+// Сетунь-1958
+// ---------------------------------------------------
+
+// Пример программы "Сетунь-1958": (S) = (x) + (y), запись S в sum
+const setunExample = `
+start:  LDS  x
+        ADD  y
+        STS  sum
+        HLT
+x:      DL   12345
+y:      DL   -678
+sum:    DL   0
+`
+
+// Ассемблировать и выполнить пример программы на эмуляторе "Сетунь-1958"
+func runSetun1958() {
+	img, err := setun.AssembleString(setunExample)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	img.WriteListing(os.Stdout)
+	m := setun.New()
+	img.Load(m)
+	if err := m.Run(100); err != nil {
+		fmt.Println("error:", err)
+	}
+	fmt.Printf(" S = %s (%d)\n", m.S, m.S.Int64())
+	fmt.Printf(" W = %s, C = %s, steps = %d\n", m.W, m.C, m.Steps)
+}
+
+// Прочитать программу "Сетунь-1958": образ перфоленты (*.tape)
+// или исходный текст ассемблера
+func readSetunProgram(path string) (*setun.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if !strings.HasSuffix(path, ".tape") {
+		return setun.Assemble(f)
+	}
+	tape, err := setun.ReadTapeImage(f)
+	if err != nil {
+		return nil, err
+	}
+	return setun.AssembleString(tape.Text())
+}
+
+// Отладчик "Сетунь-1958": goTernaryArithmetic debug [программа]
+// Без программы загружается пример; печать телетайпа — в stdout.
+func debugSetun1958(args []string) error {
+	var img *setun.Image
+	var err error
+	if len(args) > 0 {
+		img, err = readSetunProgram(args[0])
+	} else {
+		img, err = setun.AssembleString(setunExample)
+	}
+	if err != nil {
+		return err
+	}
+	m := setun.New()
+	m.IO = setun.NewDevices(nil, os.Stdout)
+	d := setun.NewDebugger(m)
+	if err := d.Load(img); err != nil {
+		return err
+	}
+	fmt.Println("Setun-1958 debugger, h for help")
+	return d.Repl(os.Stdin, os.Stdout)
+}
+
+// Выполнить программу "Сетунь-1958":
+// goTernaryArithmetic run [-tape лента] [-out файл] [-punch лента] программа
+// Печать телетайпа — в файл -out или stdout, клавиатура — stdin.
+func runSetunProgram(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	tapeIn := fs.String("tape", "", "paper tape image for the tape reader")
+	out := fs.String("out", "", "teletype output file (default stdout)")
+	punch := fs.String("punch", "", "paper tape image file for the tape punch")
+	steps := fs.Int("steps", setun.DebugMaxSteps, "maximum number of instructions")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return errors.New("usage: run [-tape file] [-out file] [-punch file] program")
+	}
+	img, err := readSetunProgram(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	dev := setun.NewDevices(nil, os.Stdout)
+	dev.Keyboard = os.Stdin
+	if *tapeIn != "" {
+		f, err := os.Open(*tapeIn)
+		if err != nil {
+			return err
+		}
+		dev.Reader, err = setun.ReadTapeImage(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		dev.Printer = f
+	}
+	m := setun.New()
+	m.IO = dev
+	if err := img.Load(m); err != nil {
+		return err
+	}
+	runErr := m.Run(*steps)
+	fmt.Fprintf(os.Stderr, "steps: %d, S = %s (%d)\n", m.Steps, m.S, m.S.Int64())
+	if *punch != "" {
+		f, err := os.Create(*punch)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err := dev.Punched.WriteImage(f); err != nil {
+			return err
+		}
+	}
+	return runErr
+}
+
+// Набить текст на перфоленту: goTernaryArithmetic punch файл
+// Образ ленты выводится в stdout.
+func punchSetunTape(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: punch file")
+	}
+	text, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	tape, err := setun.Punch(string(text))
+	if err != nil {
+		return err
+	}
+	return tape.WriteImage(os.Stdout)
+}
+
+// -------------------------------------------------------
+// TRIT Arithmetic  ver. 2.0 for architectures ARM, RISC-V
+// -------------------------------------------------------
+
+// Вызов функций из библиотеки на С
+func testCallC() {
+
+	fmt.Println("-------------------------------")
+
+	// C Library
+	//mystr := C.CString("Hello from a C library function")
+	//C.myPrintFunction(mystr)
+	//defer C.free(unsafe.Pointer(mystr))
+
+	// Inline C
+	C.myPrintFunction2()
+
+	// Inline C math
+	var X C.double
+	X = 0.5432
+	X = C.sin(X)
+	fmt.Println(X)
+
+	s2 := C.s2
+	fmt.Println(s2)
+	s1 := C.s1
+	fmt.Println(s1)
+
+	fmt.Println("-------------------------------")
+}
+
+// ---------------------------------------------------
+// Main
+// ---------------------------------------------------
+func main() {
+
+	// Подкоманды эмулятора "Сетунь-1958"
+	if len(os.Args) > 1 {
+		cmds := map[string]func([]string) error{
+			"debug": debugSetun1958,
+			"run":   runSetunProgram,
+			"punch": punchSetunTape,
+		}
+		if cmd, ok := cmds[os.Args[1]]; ok {
+			if err := cmd(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, "error:", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	fmt.Printf("Test call function trslib -----------\n")
+
+	testCallC()
+
+	fmt.Printf("Test ternary functions -----------\n")
+
+	fmt.Printf("- calculate trit-1  --------------\n")
+	// Троичные переменные
+	var a ternary.Trit
+	var b ternary.Trit
+	var c ternary.Trit
+	var carry ternary.Trit
+	// Операции на тритами
+	a.SetNil()
+	b.SetFalse()
+	c.SetTrue()
+	carry.SetTrue()
+
+	fmt.Println(" Set trit:")
+	var s ternary.Trit
+	s = s.SetTrue()
+	fmt.Println(s.SymbChar())
+	s = s.SetNil()
+	fmt.Println(s.SymbChar())
+	s = s.SetFalse()
+	fmt.Println(s.SymbChar())
+
+	s = s.Clear()
+	fmt.Println(s.SymbChar())
+
+	var t3 ternary.Trit
+	var t2 ternary.Trit
+	t3 = t3.SetFalse()
+	t2 = t2.SetNil()
+	fmt.Println(ternary.EqualTrits(t3, t2))
+	t3 = t3.SetNil()
+	t2 = t2.SetNil()
+	fmt.Println(ternary.EqualTrits(t3, t2))
+
+	fmt.Printf("- add_full_t    --------------\n")
+	var aa ternary.Trit
+	var bb ternary.Trit
+	var ccarry ternary.Trit
+	aa = aa.SetFalse()
+	bb = bb.SetFalse()
+	sf, sfc := ternary.AddFullSlowly(aa, bb, ccarry)
+	fmt.Println(sf, sfc)
+
+	fmt.Printf("- calculate trits-32 --------------\n")
+	// Троичные переменные
+	// TODO
+
+	fmt.Printf("--- Operation Setun-1958 ---\n")
+	runSetun1958()
+
+	fmt.Printf("--------------------------------\n")
+}
@@ -0,0 +1,426 @@
+/**
+ * Filename: 	asm.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package setun
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+// ----------------------------------------------------
+// Ассемблер "Сетунь-1958"
+// ----------------------------------------------------
+//
+// Строка исходного текста:
+//
+//	[метка:] [мнемоника [операнд[,модификатор]]] [; комментарий]
+//	имя EQU выражение
+//
+// Мнемоники команд — см. Ops (LDS, ADD, ..., DRD), без учета регистра.
+// Модификатор K(9): "+" — A* = A + F, "-" — A* = A - F, "0" или нет.
+// Директивы:
+//
+//	ORG  адрес   размещать далее с короткого адреса
+//	DS   число   короткое слово, 9 тритов
+//	DL   число   длинное слово, 18 тритов (в следующей свободной строке
+//	             памяти; метка получает длинный адрес)
+//	EQU  число   определить имя
+//
+// Выражение — сумма и разность произведений чисел и имен:
+// "x+2", "-3*81", "n*81+1". Числа десятичные или троичные
+// с префиксом 0t или 0T: "0t+0-" = 8.
+//
+// Размещение начинается с адреса Start; первая команда программы —
+// точка входа.
+
+// Ошибка ассемблирования в строке Line
+type AsmError struct {
+	Line int
+	Msg  string
+}
+
+func (e *AsmError) Error() string {
+	return fmt.Sprintf("setun: asm line %d: %s", e.Line, e.Msg)
+}
+
+// errors.Is(err, ternary.ErrSyntax) == true
+func (e *AsmError) Unwrap() error {
+	return ternary.ErrSyntax
+}
+
+// Строка листинга
+type ListLine struct {
+	Line   int          // номер строки исходного текста
+	Addr   int          // адрес слова
+	Word   ternary.Word // слово; Len() == 0 для строк без слова
+	Source string       // исходный текст
+}
+
+// Образ памяти, полученный ассемблером
+type Image struct {
+	Start   int                  // точка входа
+	Cells   map[int]ternary.Word // короткие слова по адресам
+	Symbols map[string]int       // метки и имена EQU
+	Listing []ListLine
+}
+
+// Загрузить образ в память машины и установить C на точку входа
+func (img *Image) Load(m *Machine) error {
+	for a, w := range img.Cells {
+		if err := m.Mem.Poke(a, w); err != nil {
+			return err
+		}
+	}
+	m.C = word(int64(img.Start), 5)
+	m.Halted = false
+	return nil
+}
+
+// Адреса слов образа по возрастанию
+func (img *Image) Addrs() []int {
+	as := make([]int, 0, len(img.Cells))
+	for a := range img.Cells {
+		as = append(as, a)
+	}
+	sort.Ints(as)
+	return as
+}
+
+// Разобранная строка исходного текста
+type asmLine struct {
+	num     int
+	src     string
+	label   string
+	op      string // мнемоника или директива в верхнем регистре
+	arg     string
+	mod     int8
+	addr    int
+	hasAddr bool
+}
+
+type assembler struct {
+	lines []*asmLine
+	syms  map[string]int
+}
+
+func asmErr(line int, format string, args ...interface{}) error {
+	return &AsmError{line, fmt.Sprintf(format, args...)}
+}
+
+// Допустимое имя: буква или '_', далее буквы, цифры, '_'
+func isName(s string) bool {
+	for i, c := range s {
+		letter := c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+		if !letter && (i == 0 || c < '0' || c > '9') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// Разобрать строку на метку, мнемонику, операнд и модификатор
+func splitLine(num int, src string) (*asmLine, error) {
+	l := &asmLine{num: num, src: src}
+	s := src
+	if i := strings.IndexByte(s, ';'); i >= 0 {
+		s = s[:i]
+	}
+	f := strings.Fields(s)
+	hasLabel := false
+	if len(f) > 0 && strings.HasSuffix(f[0], ":") {
+		l.label, hasLabel = strings.TrimSuffix(f[0], ":"), true
+		f = f[1:]
+	} else if len(f) > 1 && strings.EqualFold(f[1], "EQU") {
+		l.label, hasLabel = f[0], true
+		f = f[1:]
+	}
+	if hasLabel && !isName(l.label) {
+		return nil, asmErr(num, "bad label %q", l.label)
+	}
+	if len(f) == 0 {
+		return l, nil
+	}
+	l.op = strings.ToUpper(f[0])
+	if l.op == "EQU" && !hasLabel {
+		return nil, asmErr(num, "EQU requires a name")
+	}
+	arg := strings.Join(f[1:], "")
+	if i := strings.IndexByte(arg, ','); i >= 0 {
+		switch arg[i+1:] {
+		case "+":
+			l.mod = 1
+		case "-":
+			l.mod = -1
+		case "0":
+		default:
+			return nil, asmErr(num, "bad modifier %q", arg[i+1:])
+		}
+		arg = arg[:i]
+	}
+	l.arg = arg
+	return l, nil
+}
+
+// Начинается ли строка с префикса троичного числа 0t (0T на ленте
+// телетайпа без строчных букв)
+func hasTernaryPrefix(s string) bool {
+	return strings.HasPrefix(s, "0t") || strings.HasPrefix(s, "0T")
+}
+
+// Разобрать число: десятичное или троичное 0t...
+func parseNumber(s string) (int64, bool) {
+	if hasTernaryPrefix(s) {
+		w, err := ternary.ParseWord(s[2:], ternary.AlphabetTrit)
+		if err != nil || w.Len() == 0 {
+			return 0, false
+		}
+		return w.Int64(), true
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	return n, err == nil
+}
+
+// Вычислить выражение; неизвестные имена — ошибка
+func (as *assembler) eval(num int, s string) (int64, error) {
+	if s == "" {
+		return 0, asmErr(num, "missing operand")
+	}
+	var sum int64
+	// разбить на слагаемые по знакам + и -, не относящимся к числу 0t...
+	i, sign := 0, int64(1)
+	if s[0] == '-' || s[0] == '+' {
+		if s[0] == '-' {
+			sign = -1
+		}
+		i = 1
+	}
+	for i <= len(s) {
+		j := i
+		for j < len(s) && s[j] != '+' && s[j] != '-' {
+			if hasTernaryPrefix(s[j:]) && (j == i || s[j-1] == '*') {
+				j += 2
+				for j < len(s) && strings.IndexByte("-0+", s[j]) >= 0 {
+					j++
+				}
+				continue
+			}
+			j++
+		}
+		prod := int64(1)
+		for _, f := range strings.Split(s[i:j], "*") {
+			if v, ok := parseNumber(f); ok {
+				prod *= v
+			} else if v, ok := as.syms[f]; ok && isName(f) {
+				prod *= int64(v)
+			} else if isName(f) {
+				return 0, asmErr(num, "undefined name %q", f)
+			} else {
+				return 0, asmErr(num, "bad expression %q", s)
+			}
+		}
+		sum += sign * prod
+		if j == len(s) {
+			break
+		}
+		sign = 1
+		if s[j] == '-' {
+			sign = -1
+		}
+		i = j + 1
+	}
+	return sum, nil
+}
+
+// Ассемблировать исходный текст
+func Assemble(r io.Reader) (*Image, error) {
+	as := &assembler{syms: map[string]int{}}
+	sc := bufio.NewScanner(r)
+	for num := 1; sc.Scan(); num++ {
+		l, err := splitLine(num, sc.Text())
+		if err != nil {
+			return nil, err
+		}
+		as.lines = append(as.lines, l)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if err := as.layout(); err != nil {
+		return nil, err
+	}
+	return as.emit()
+}
+
+// Ассемблировать текст из строки
+func AssembleString(src string) (*Image, error) {
+	return Assemble(strings.NewReader(src))
+}
+
+func (as *assembler) define(l *asmLine, v int) error {
+	if _, ok := as.syms[l.label]; ok {
+		return asmErr(l.num, "duplicate name %q", l.label)
+	}
+	as.syms[l.label] = v
+	return nil
+}
+
+// Первый проход: адреса слов и значения меток
+func (as *assembler) layout() error {
+	loc, used := Start, 0
+	// занять короткое слово loc
+	advance := func(l *asmLine) error {
+		if used++; used > ShortWords {
+			return asmErr(l.num, "program exceeds memory")
+		}
+		loc = Next(loc)
+		return nil
+	}
+	for _, l := range as.lines {
+		switch l.op {
+		case "EQU":
+			v, err := as.eval(l.num, l.arg)
+			if err != nil {
+				return err
+			}
+			if err := as.define(l, int(v)); err != nil {
+				return err
+			}
+			continue
+		case "ORG":
+			v, err := as.eval(l.num, l.arg)
+			if err != nil {
+				return err
+			}
+			if v < -AddrMax || v > AddrMax || IsLong(int(v)) {
+				return asmErr(l.num, "ORG %d is not a short address", v)
+			}
+			loc, used = int(v), 0
+		case "DL":
+			// длинное слово — строка памяти с обоими короткими словами
+			if wrap(int64(loc), 1) == 1 {
+				if err := advance(l); err != nil {
+					return err
+				}
+			}
+			l.addr, l.hasAddr = loc+1, true
+			if err := advance(l); err != nil {
+				return err
+			}
+			if err := advance(l); err != nil {
+				return err
+			}
+		case "":
+		default:
+			if _, ok := LookupOp(l.op); !ok && l.op != "DS" {
+				return asmErr(l.num, "unknown mnemonic %q", l.op)
+			}
+			l.addr, l.hasAddr = loc, true
+			if err := advance(l); err != nil {
+				return err
+			}
+		}
+		if l.label != "" {
+			v := loc
+			if l.hasAddr {
+				v = l.addr
+			}
+			if err := as.define(l, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Второй проход: слова образа и листинг
+func (as *assembler) emit() (*Image, error) {
+	img := &Image{Start: Start, Cells: map[int]ternary.Word{}, Symbols: as.syms}
+	entry := false
+	put := func(l *asmLine, a int, w ternary.Word) error {
+		if _, ok := img.Cells[a]; ok {
+			return asmErr(l.num, "address %d is already used", a)
+		}
+		img.Cells[a] = w
+		return nil
+	}
+	for _, l := range as.lines {
+		ll := ListLine{Line: l.num, Addr: l.addr, Source: l.src}
+		if l.hasAddr {
+			var err error
+			switch l.op {
+			case "DS", "DL":
+				ll.Word, err = as.data(l)
+				if err == nil && l.op == "DL" {
+					v := ll.Word.Int64()
+					err = put(l, l.addr-1, word(round(v, 9), 9))
+					if err == nil {
+						err = put(l, l.addr+1, word(v, 9))
+					}
+				} else if err == nil {
+					err = put(l, l.addr, ll.Word)
+				}
+			default:
+				ll.Word, err = as.instruction(l)
+				if err == nil {
+					err = put(l, l.addr, ll.Word)
+				}
+				if !entry {
+					img.Start, entry = l.addr, true
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		img.Listing = append(img.Listing, ll)
+	}
+	return img, nil
+}
+
+// Слово данных DS или DL
+func (as *assembler) data(l *asmLine) (ternary.Word, error) {
+	v, err := as.eval(l.num, l.arg)
+	if err != nil {
+		return ternary.Word{}, err
+	}
+	n := uint8(9)
+	if l.op == "DL" {
+		n = 18
+	}
+	if max := (pow3(uint(n)) - 1) / 2; v < -max || v > max {
+		return ternary.Word{}, asmErr(l.num, "%d does not fit in %d trits", v, n)
+	}
+	return word(v, n), nil
+}
+
+// Команда K(1:9)
+func (as *assembler) instruction(l *asmLine) (ternary.Word, error) {
+	op, _ := LookupOp(l.op)
+	a := int64(0)
+	if l.arg != "" || op != OpHLT {
+		var err error
+		if a, err = as.eval(l.num, l.arg); err != nil {
+			return ternary.Word{}, err
+		}
+	}
+	if a < -AddrMax || a > AddrMax {
+		return ternary.Word{}, asmErr(l.num, "address %d out of range", a)
+	}
+	return Encode(op, int(a), l.mod), nil
+}
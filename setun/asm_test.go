@@ -0,0 +1,126 @@
+package setun
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+const sumProgram = `
+; сумма таблицы длинных чисел с циклом по индексному регистру
+step    EQU  3              ; длинные слова идут через 3 адреса
+start:  LDF  count          ; F = 6
+loop:   ADD  tab,+          ; S += (tab + F)
+        ADF  minus
+        JM   done
+        JMP  loop
+done:   STS  sum
+        HLT
+count:  DS   2*step*81
+minus:  DS   0t-0000*step   ; -3 в F(1:5)
+tab:    DL   100
+        DL   -20
+        DL   0t+0-
+sum:    DL   0
+`
+
+func Test_assemble_run(t *testing.T) {
+	img, err := AssembleString(sumProgram)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Start != Start || img.Symbols["loop"] != 2 || img.Symbols["step"] != 3 {
+		t.Errorf("start %d, symbols %v", img.Start, img.Symbols)
+	}
+	for _, name := range []string{"tab", "sum"} {
+		if a := img.Symbols[name]; !IsLong(a) {
+			t.Errorf("%s at short address %d", name, a)
+		}
+	}
+	m := New()
+	if err := img.Load(m); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Run(100); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := read(t, m, img.Symbols["sum"]), int64(100-20+8); got != want {
+		t.Errorf("sum = %d, want %d", got, want)
+	}
+}
+
+func Test_listing(t *testing.T) {
+	img, err := AssembleString("start: LDS x\n; data\nx: DS 0t+-\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := img.WriteListing(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := "" +
+		"01  000+-+000           070     start: LDS x\n" +
+		"                                ; data\n" +
+		"02  0000000+-           002     x: DS 0t+-\n"
+	if b.String() != want {
+		t.Errorf("listing:\n%s\nwant:\n%s", b.String(), want)
+	}
+}
+
+func Test_disasm_roundtrip(t *testing.T) {
+	for v := int64(-9841); v <= 9841; v++ {
+		k := word(v, 9)
+		img, err := AssembleString(Disasm(k))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := img.Cells[Start]; got.Int64() != v {
+			t.Fatalf("%s: assembled %s, want %s", Disasm(k), got, k)
+		}
+	}
+}
+
+func Test_disasm_memory(t *testing.T) {
+	m := New()
+	m.Mem.Poke(1, Encode(OpADD, 30, 1))
+	m.Mem.Poke(2, Encode(OpHLT, 0, 0))
+	var b bytes.Buffer
+	if err := WriteDisasm(&b, m.Mem, 0, 2); err != nil {
+		t.Fatal(err)
+	}
+	want := "" +
+		"01  0+0+0+0++  3A4  ADD  30,+\n" +
+		"02  00000+--0  01N  HLT  0\n"
+	if b.String() != want {
+		t.Errorf("disasm:\n%s\nwant:\n%s", b.String(), want)
+	}
+}
+
+func Test_assemble_errors(t *testing.T) {
+	for _, src := range []string{
+		"LDS nowhere",
+		"x: DS 1\nx: DS 2",
+		"FOO 1",
+		"ADD 1,x",
+		"DS 9842",
+		"DL 193710245",
+		"ORG 3",
+		"LDS 122",
+		"ORG 1\nDS 1\nORG 1\nDS 2",
+		"1x: HLT",
+		"DS 0t",
+		"EQU 5",
+		"equ 5",
+		": DS 1",
+		"x:: EQU 1",
+		"1x EQU 2",
+	} {
+		_, err := AssembleString(src)
+		var ae *AsmError
+		if !errors.As(err, &ae) || !errors.Is(err, ternary.ErrSyntax) {
+			t.Errorf("AssembleString(%q) = %v", src, err)
+		}
+	}
+}
@@ -0,0 +1,523 @@
+/**
+ * Filename: 	debug.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package setun
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+// ----------------------------------------------------
+// Отладчик
+// ----------------------------------------------------
+//
+// Команды отладчика (адреса — числа, 0t-троичные числа или метки
+// загруженной программы, допускаются выражения ассемблера "loop+1"):
+//
+//	s [n]            выполнить n команд (по умолчанию 1)
+//	c                выполнять до останова, точки останова или наблюдения
+//	b [адрес]        поставить точку останова / список точек
+//	w [адрес]        наблюдать за ячейкой памяти / список наблюдений
+//	d адрес          удалить точку останова и наблюдение по адресу
+//	r                регистры в троичной и десятичной записи
+//	m адрес [n]      n коротких слов памяти с дизассемблером
+//	set адрес число  записать число в ячейку памяти
+//	set адрес команда  записать команду ассемблера: set 5 ADD x,+
+//	set рег число    записать число в регистр S, R, F, CR, W, MB
+//	t [n]            последние n команд трассы
+//	load файл        ассемблировать и загрузить программу
+//	reset            аппаратный сброс
+//	h                справка
+//	q                выход
+
+// Наибольшее число команд одной команды отладчика "c"
+const DebugMaxSteps = 1000000
+
+// Размер трассы выполнения
+const TraceSize = 1000
+
+// Запись трассы: команда и состояние S после ее выполнения
+type TraceEntry struct {
+	Step uint64       // номер команды
+	Addr int          // адрес команды
+	K    ternary.Word // команда
+	S    ternary.Word // S после выполнения
+	W    ternary.Word // W после выполнения
+}
+
+func (e TraceEntry) String() string {
+	return fmt.Sprintf("%6d  %s  %s  %-10s  S=%s %d  W=%s",
+		e.Step, HeptaAddr(e.Addr), e.K, Disasm(e.K), e.S, e.S.Int64(), e.W)
+}
+
+// Причина остановки выполнения
+type Stop int
+
+const (
+	StopSteps      Stop = iota // выполнено заданное число команд
+	StopHalt                   // команда останова или ошибка
+	StopBreakpoint             // точка останова
+	StopWatchpoint             // изменилась наблюдаемая ячейка
+)
+
+var stopNames = [...]string{"steps", "halt", "breakpoint", "watchpoint"}
+
+func (s Stop) String() string {
+	return stopNames[s]
+}
+
+// Отладчик машины "Сетунь-1958"
+type Debugger struct {
+	M       *Machine
+	Symbols map[string]int // метки загруженной программы
+
+	breaks  map[int]bool
+	watches map[int]ternary.Word // наблюдаемые ячейки и их значения
+	trace   []TraceEntry         // кольцевой буфер трассы
+	head    int                  // самая старая запись заполненного буфера
+	changed int                  // адрес изменившейся наблюдаемой ячейки
+}
+
+// Создать отладчик машины m
+func NewDebugger(m *Machine) *Debugger {
+	return &Debugger{
+		M:       m,
+		Symbols: map[string]int{},
+		breaks:  map[int]bool{},
+		watches: map[int]ternary.Word{},
+	}
+}
+
+// Загрузить образ программы и его метки
+func (d *Debugger) Load(img *Image) error {
+	if err := img.Load(d.M); err != nil {
+		return err
+	}
+	d.Symbols = img.Symbols
+	d.syncWatches()
+	return nil
+}
+
+// Поставить точку останова на команду по адресу a
+func (d *Debugger) Break(a int) error {
+	if err := checkAddr(a); err != nil {
+		return err
+	}
+	d.breaks[a] = true
+	return nil
+}
+
+// Наблюдать за ячейкой памяти a (короткой или длинной)
+func (d *Debugger) Watch(a int) error {
+	w, err := d.M.Mem.Peek(a)
+	if err != nil {
+		return err
+	}
+	d.watches[a] = w
+	return nil
+}
+
+// Удалить точку останова и наблюдение по адресу a
+func (d *Debugger) Delete(a int) {
+	delete(d.breaks, a)
+	delete(d.watches, a)
+}
+
+// Запомнить текущие значения наблюдаемых ячеек
+func (d *Debugger) syncWatches() {
+	for a := range d.watches {
+		d.watches[a], _ = d.M.Mem.Peek(a)
+	}
+}
+
+// Трасса: последние n выполненных команд (n <= 0 — вся)
+func (d *Debugger) Trace(n int) []TraceEntry {
+	if n <= 0 || n > len(d.trace) {
+		n = len(d.trace)
+	}
+	r := make([]TraceEntry, 0, n)
+	for i := len(d.trace) - n; i < len(d.trace); i++ {
+		r = append(r, d.trace[(d.head+i)%len(d.trace)])
+	}
+	return r
+}
+
+// Добавить запись в трассу, заменяя самую старую при заполнении
+func (d *Debugger) record(e TraceEntry) {
+	if len(d.trace) < TraceSize {
+		d.trace = append(d.trace, e)
+		return
+	}
+	d.trace[d.head] = e
+	d.head = (d.head + 1) % TraceSize
+}
+
+// Выполнить одну команду с записью в трассу и проверкой наблюдений
+func (d *Debugger) step() (Stop, error) {
+	m := d.M
+	a, steps := int(m.C.Int64()), m.Steps
+	err := m.Step()
+	if m.Steps != steps {
+		d.record(TraceEntry{m.Steps, a, m.K, m.S, m.W})
+	}
+	if err != nil || m.Halted {
+		return StopHalt, err
+	}
+	for _, a := range d.watchAddrs() {
+		w, _ := m.Mem.Peek(a)
+		if w != d.watches[a] {
+			d.changed = a
+			d.syncWatches()
+			return StopWatchpoint, nil
+		}
+	}
+	return StopSteps, nil
+}
+
+// Выполнить n команд
+func (d *Debugger) Step(n int) (Stop, error) {
+	for i := 0; i < n; i++ {
+		if s, err := d.step(); s != StopSteps || err != nil {
+			return s, err
+		}
+	}
+	return StopSteps, nil
+}
+
+// Выполнять команды до останова, точки останова или изменения
+// наблюдаемой ячейки, но не более max команд
+func (d *Debugger) Continue(max int) (Stop, error) {
+	for i := 0; i < max; i++ {
+		if i > 0 && d.breaks[int(d.M.C.Int64())] {
+			return StopBreakpoint, nil
+		}
+		if s, err := d.step(); s != StopSteps || err != nil {
+			return s, err
+		}
+	}
+	return StopSteps, nil
+}
+
+// Адреса точек останова по возрастанию
+func (d *Debugger) breakAddrs() []int {
+	var as []int
+	for a := range d.breaks {
+		as = append(as, a)
+	}
+	sort.Ints(as)
+	return as
+}
+
+// Адреса наблюдаемых ячеек по возрастанию
+func (d *Debugger) watchAddrs() []int {
+	var as []int
+	for a := range d.watches {
+		as = append(as, a)
+	}
+	sort.Ints(as)
+	return as
+}
+
+// Записать регистры в троичной и десятичной записи
+func (d *Debugger) WriteRegs(w io.Writer) {
+	m := d.M
+	for _, r := range []struct {
+		name string
+		w    ternary.Word
+	}{
+		{"S", m.S}, {"R", m.R}, {"F", m.F}, {"CR", m.C}, {"W", m.W},
+		{"ph1", m.Ph1}, {"ph2", m.Ph2}, {"K", m.K}, {"MB", m.MB},
+	} {
+		fmt.Fprintf(w, "%-3s = %-18s  %d\n", r.name, r.w, r.w.Int64())
+	}
+	fmt.Fprintf(w, "next: %s  %s\n", HeptaAddr(int(m.C.Int64())), d.nextInstr())
+}
+
+// Следующая команда в записи ассемблера
+func (d *Debugger) nextInstr() string {
+	k, err := d.M.Mem.Peek(int(d.M.C.Int64()))
+	if err != nil || k.Len() != 9 {
+		return "-"
+	}
+	return Disasm(k)
+}
+
+// Вычислить адрес или число: выражение ассемблера с метками программы
+func (d *Debugger) value(s string) (int64, error) {
+	as := &assembler{syms: d.Symbols}
+	return as.eval(0, s)
+}
+
+func (d *Debugger) addr(s string) (int, error) {
+	v, err := d.value(s)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkAddr(int(v)); err != nil {
+		return 0, err
+	}
+	return int(v), nil
+}
+
+// Вычислить число, помещающееся в l тритов
+func (d *Debugger) fit(s string, l uint8) (int64, error) {
+	v, err := d.value(s)
+	if err != nil {
+		return 0, err
+	}
+	if max := (pow3(uint(l)) - 1) / 2; v < -max || v > max {
+		return 0, fmt.Errorf("%d does not fit in %d trits", v, l)
+	}
+	return v, nil
+}
+
+// Регистр машины по имени
+func (d *Debugger) register(name string) (*ternary.Word, bool) {
+	m := d.M
+	regs := map[string]*ternary.Word{
+		"S": &m.S, "R": &m.R, "F": &m.F, "CR": &m.C, "C": &m.C, "W": &m.W, "MB": &m.MB,
+	}
+	r, ok := regs[strings.ToUpper(name)]
+	return r, ok
+}
+
+// Команда "set": записать число или команду в ячейку или регистр
+func (d *Debugger) set(args []string) error {
+	if len(args) < 2 {
+		return errors.New("usage: set addr|reg value")
+	}
+	expr := strings.Join(args[1:], "")
+	if r, ok := d.register(args[0]); ok {
+		v, err := d.fit(expr, r.Len())
+		if err != nil {
+			return err
+		}
+		*r = word(v, r.Len())
+		return nil
+	}
+	a, err := d.addr(args[0])
+	if err != nil {
+		return err
+	}
+	l := uint8(9)
+	if IsLong(a) {
+		l = 18
+	}
+	var v int64
+	if _, ok := LookupOp(args[1]); ok {
+		// команда ассемблера: set 5 ADD x,+
+		if l != 9 {
+			return fmt.Errorf("instruction at long address %d", a)
+		}
+		line, err := splitLine(0, strings.Join(args[1:], " "))
+		if err != nil {
+			return err
+		}
+		as := &assembler{syms: d.Symbols}
+		k, err := as.instruction(line)
+		if err != nil {
+			return err
+		}
+		v = k.Int64()
+	} else if v, err = d.fit(expr, l); err != nil {
+		return err
+	}
+	if err := d.M.Mem.Poke(a, word(v, l)); err != nil {
+		return err
+	}
+	if _, ok := d.watches[a]; ok {
+		d.watches[a] = word(v, l)
+	}
+	return nil
+}
+
+// Сообщение об остановке
+func (d *Debugger) report(w io.Writer, s Stop, err error) {
+	switch {
+	case err != nil:
+		fmt.Fprintf(w, "stopped: %v\n", err)
+	case s == StopWatchpoint:
+		v := d.watches[d.changed]
+		fmt.Fprintf(w, "watchpoint %s: %s %d\n", HeptaAddr(d.changed), v, v.Int64())
+	case s == StopBreakpoint:
+		fmt.Fprintf(w, "breakpoint %s\n", HeptaAddr(int(d.M.C.Int64())))
+	case s == StopHalt:
+		fmt.Fprintf(w, "halted after %d steps\n", d.M.Steps)
+	}
+}
+
+// Числовой аргумент команды или значение по умолчанию
+func (d *Debugger) count(args []string, i int, def int) (int, error) {
+	if len(args) <= i {
+		return def, nil
+	}
+	v, err := d.value(args[i])
+	return int(v), err
+}
+
+// Выполнить одну команду отладчика, вывод в w
+// Возвращает true для команды выхода.
+func (d *Debugger) Exec(line string, w io.Writer) (bool, error) {
+	f := strings.Fields(line)
+	if len(f) == 0 {
+		return false, nil
+	}
+	cmd, args := f[0], f[1:]
+	switch cmd {
+	case "q", "quit":
+		return true, nil
+	case "h", "help":
+		fmt.Fprint(w, debugHelp)
+	case "s", "step":
+		n, err := d.count(args, 0, 1)
+		if err != nil {
+			return false, err
+		}
+		steps := d.M.Steps
+		s, err := d.Step(n)
+		if k := int(d.M.Steps - steps); k > 0 {
+			for _, e := range d.Trace(k) {
+				fmt.Fprintln(w, e)
+			}
+		}
+		d.report(w, s, err)
+	case "c", "cont":
+		s, err := d.Continue(DebugMaxSteps)
+		d.report(w, s, err)
+		fmt.Fprintf(w, "next: %s  %s\n", HeptaAddr(int(d.M.C.Int64())), d.nextInstr())
+	case "b", "break", "w", "watch":
+		if len(args) == 0 {
+			set := d.breakAddrs()
+			if cmd[0] == 'w' {
+				set = d.watchAddrs()
+			}
+			for _, a := range set {
+				fmt.Fprintf(w, "%s  %d\n", HeptaAddr(a), a)
+			}
+			return false, nil
+		}
+		a, err := d.addr(args[0])
+		if err != nil {
+			return false, err
+		}
+		if cmd[0] == 'w' {
+			return false, d.Watch(a)
+		}
+		return false, d.Break(a)
+	case "d", "delete":
+		if len(args) == 0 {
+			return false, errors.New("usage: d addr")
+		}
+		a, err := d.addr(args[0])
+		if err != nil {
+			return false, err
+		}
+		d.Delete(a)
+	case "r", "regs":
+		d.WriteRegs(w)
+	case "m", "mem":
+		if len(args) == 0 {
+			return false, errors.New("usage: m addr [n]")
+		}
+		a, err := d.addr(args[0])
+		if err != nil {
+			return false, err
+		}
+		n, err := d.count(args, 1, 1)
+		if err != nil {
+			return false, err
+		}
+		if IsLong(a) {
+			v, _ := d.M.Mem.Peek(a)
+			fmt.Fprintf(w, "%s  %s  %s  %d\n", HeptaAddr(a), v, HeptaWord(v), v.Int64())
+			return false, nil
+		}
+		to := a
+		for i := 1; i < n && Next(to) > to; i++ {
+			to = Next(to)
+		}
+		return false, WriteDisasm(w, d.M.Mem, a, to)
+	case "set":
+		return false, d.set(args)
+	case "t", "trace":
+		n, err := d.count(args, 0, 10)
+		if err != nil {
+			return false, err
+		}
+		for _, e := range d.Trace(n) {
+			fmt.Fprintln(w, e)
+		}
+	case "load":
+		if len(args) != 1 {
+			return false, errors.New("usage: load file")
+		}
+		src, err := os.Open(args[0])
+		if err != nil {
+			return false, err
+		}
+		defer src.Close()
+		img, err := Assemble(src)
+		if err != nil {
+			return false, err
+		}
+		return false, d.Load(img)
+	case "reset":
+		d.M.Reset()
+		d.trace, d.head = nil, 0
+		d.syncWatches()
+	default:
+		return false, fmt.Errorf("unknown command %q, h for help", cmd)
+	}
+	return false, nil
+}
+
+const debugHelp = `s [n]             step n instructions
+c                 continue to halt, breakpoint or watchpoint
+b [addr]          set breakpoint / list breakpoints
+w [addr]          watch memory cell / list watchpoints
+d addr            delete breakpoint and watchpoint
+r                 show registers
+m addr [n]        show n memory words
+set addr value    write number or instruction to memory
+set reg value     write number to S, R, F, CR, W, MB
+t [n]             show last n trace entries
+load file         assemble and load program
+reset             hardware reset
+q                 quit
+`
+
+// Диалог отладчика: команды читаются из r, вывод в w
+func (d *Debugger) Repl(r io.Reader, w io.Writer) error {
+	sc := bufio.NewScanner(r)
+	for {
+		fmt.Fprint(w, "(setun) ")
+		if !sc.Scan() {
+			fmt.Fprintln(w)
+			return sc.Err()
+		}
+		quit, err := d.Exec(sc.Text(), w)
+		if err != nil {
+			fmt.Fprintln(w, "error:", err)
+		}
+		if quit {
+			return nil
+		}
+	}
+}
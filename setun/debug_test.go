@@ -0,0 +1,148 @@
+package setun
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func newDebugger(t *testing.T, src string) *Debugger {
+	t.Helper()
+	img, err := AssembleString(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDebugger(New())
+	if err := d.Load(img); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func Test_debugger_break_watch(t *testing.T) {
+	d := newDebugger(t, sumProgram)
+	if err := d.Break(d.Symbols["loop"]); err != nil {
+		t.Fatal(err)
+	}
+	// LDF; ADD, ADF, JM, JMP — остановки перед первым и вторым
+	// проходом цикла
+	for _, steps := range []uint64{1, 5} {
+		if s, err := d.Continue(100); s != StopBreakpoint || err != nil || d.M.Steps != steps {
+			t.Fatalf("Continue() = %s, %v after %d steps", s, err, d.M.Steps)
+		}
+	}
+	d.Delete(d.Symbols["loop"])
+	if err := d.Watch(d.Symbols["sum"]); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := d.Continue(100); s != StopWatchpoint || err != nil {
+		t.Fatalf("Continue() = %s, %v", s, err)
+	}
+	if got := read(t, d.M, d.Symbols["sum"]); got != 88 {
+		t.Errorf("sum = %d at watchpoint", got)
+	}
+	if s, err := d.Continue(100); s != StopHalt || err != nil {
+		t.Fatalf("Continue() = %s, %v", s, err)
+	}
+	tr := d.Trace(2)
+	if len(tr) != 2 || Disasm(tr[1].K) != "HLT  0" || tr[0].S.Int64() != 88 {
+		t.Errorf("trace %v", tr)
+	}
+	if n := len(d.Trace(0)); n != int(d.M.Steps) {
+		t.Errorf("trace has %d entries after %d steps", n, d.M.Steps)
+	}
+}
+
+func Test_debugger_repl(t *testing.T) {
+	d := newDebugger(t, sumProgram)
+	script := strings.Join([]string{
+		"set tab 0t+--", // длинное слово 5
+		"set done HLT",  // остановиться вместо записи суммы
+		"set R 0t+-",
+		"s", // LDF count
+		"b done",
+		"c",
+		"r",
+		"m count 2",
+		"t 1",
+		"foo",
+		"q",
+		"s", // после выхода не выполняется
+	}, "\n")
+	var out bytes.Buffer
+	if err := d.Repl(strings.NewReader(script), &out); err != nil {
+		t.Fatal(err)
+	}
+	got := out.String()
+	for _, want := range []string{
+		"     1  01  00++-0000  LDF  11     S=000000000000000000 0  W=+",
+		"breakpoint 08",
+		"S   = 000000000000000-+-  -7", // 5 - 20 + 8
+		"R   = 0000000000000000+-  2",
+		"F   = 000-0               -3",
+		"CR  = 00+0-               8",
+		"next: 08  HLT  0",
+		"0B  00+-00000  1R0  LDF  6",
+		"0D  000-00000  0R0  LDF  -3",
+		"    12  05  00+0-0+-0  JM   8      S=000000000000000-+- -7  W=-\n",
+		"error: unknown command \"foo\"",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output does not contain %q:\n%s", want, got)
+		}
+	}
+	if d.M.Steps != 12 {
+		t.Errorf("steps = %d, want 12", d.M.Steps)
+	}
+}
+
+func Test_debugger_trace_ring(t *testing.T) {
+	d := newDebugger(t, "loop: JMP loop")
+	const n = TraceSize + 5
+	if s, err := d.Continue(n); s != StopSteps || err != nil {
+		t.Fatalf("Continue() = %s, %v", s, err)
+	}
+	tr := d.Trace(0)
+	if len(tr) != TraceSize {
+		t.Fatalf("trace has %d entries, want %d", len(tr), TraceSize)
+	}
+	for i, e := range tr {
+		if want := uint64(n - TraceSize + 1 + i); e.Step != want {
+			t.Fatalf("trace[%d].Step = %d, want %d", i, e.Step, want)
+		}
+	}
+	if last := d.Trace(1); len(last) != 1 || last[0].Step != n {
+		t.Errorf("Trace(1) = %v", last)
+	}
+}
+
+func Test_debugger_set(t *testing.T) {
+	d := newDebugger(t, sumProgram)
+	for _, c := range []struct {
+		args []string
+		ok   bool
+	}{
+		{[]string{"F", "121"}, true},
+		{[]string{"F", "122"}, false}, // F(1:5)
+		{[]string{"W", "-2"}, false},  // W(1:1)
+		{[]string{"S", "0t+-"}, true},
+		{[]string{"CR", "5"}, true},
+		{[]string{"done", "HLT"}, true},
+		{[]string{"done", "9842"}, false},
+		{[]string{"done", "ADD", "nosuch"}, false},
+		{[]string{"tab", "ADD", "tab"}, false}, // длинная ячейка
+	} {
+		if err := d.set(c.args); (err == nil) != c.ok {
+			t.Errorf("set %v: error %v", c.args, err)
+		}
+	}
+	if d.M.C.Int64() != 5 {
+		t.Errorf("CR = %d, want 5", d.M.C.Int64())
+	}
+	if d.M.F.Int64() != 121 || d.M.W.Len() != 1 {
+		t.Errorf("F = %d, W = %s", d.M.F.Int64(), d.M.W)
+	}
+	if k, _ := d.M.Mem.Peek(d.Symbols["done"]); Disasm(k) != "HLT  0" {
+		t.Errorf("done: %s", Disasm(k))
+	}
+}
@@ -0,0 +1,106 @@
+/**
+ * Filename: 	disasm.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package setun
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+// ----------------------------------------------------
+// Дизассемблер и листинги
+// ----------------------------------------------------
+//
+// Адреса в листингах записываются, как на пульте "Сетунь-1958",
+// 27-ричными цифрами M..Z (-13..-1, без O), 0, 1..9, A..D (1..13):
+// адрес A(1:5) — две цифры, короткое слово — три, длинное — шесть.
+
+// 27-ричная запись адреса (две цифры)
+func HeptaAddr(a int) string {
+	return ternary.Tryte6(a).Heptavigesimal()
+}
+
+// 27-ричная запись короткого (3 цифры) или длинного (6 цифр) слова
+func HeptaWord(w ternary.Word) string {
+	v := w.Int64()
+	if w.Len() <= 9 {
+		return ternary.Tryte9(v).Heptavigesimal()
+	}
+	return ternary.Tryte9(round(v, 9)).Heptavigesimal() + ternary.Tryte9(wrap(v, 9)).Heptavigesimal()
+}
+
+// Команда K(1:9) в записи ассемблера: "ADD 30,+"
+// Слово с неиспользуемым кодом операции записывается директивой DS.
+func Disasm(k ternary.Word) string {
+	op, a, mod := Decode(k)
+	if !op.Valid() {
+		return fmt.Sprintf("DS %d", k.Int64())
+	}
+	s := fmt.Sprintf("%-4s %d", op, a)
+	switch mod {
+	case 1:
+		s += ",+"
+	case -1:
+		s += ",-"
+	}
+	return s
+}
+
+// Записать листинг ассемблера: адрес, слово тритами, слово 27-ричными
+// цифрами и исходная строка
+//
+//	01  000+-+000           070     start: LDS x
+func (img *Image) WriteListing(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, l := range img.Listing {
+		var line string
+		if l.Word.Len() == 0 {
+			line = fmt.Sprintf("%-32s%s", "", l.Source)
+		} else {
+			line = fmt.Sprintf("%s  %-18s  %-6s  %s", HeptaAddr(l.Addr), l.Word, HeptaWord(l.Word), l.Source)
+		}
+		fmt.Fprintln(bw, strings.TrimRight(line, " \t"))
+	}
+	return bw.Flush()
+}
+
+// Записать дизассемблированный листинг коротких слов памяти от адреса
+// from до адреса to включительно
+//
+//	01  0+0+0+0++  3A4  ADD  30,+
+func WriteDisasm(w io.Writer, mem *Memory, from int, to int) error {
+	if err := checkAddr(from); err != nil {
+		return err
+	}
+	if err := checkAddr(to); err != nil {
+		return err
+	}
+	if IsLong(from) {
+		from++
+	}
+	bw := bufio.NewWriter(w)
+	for a := from; a <= to; {
+		k, _ := mem.Peek(a)
+		fmt.Fprintf(bw, "%s  %s  %s  %s\n", HeptaAddr(a), k, HeptaWord(k), Disasm(k))
+		n := Next(a)
+		if n < a {
+			break
+		}
+		a = n
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,158 @@
+/**
+ * Filename: 	drum.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package setun
+
+import (
+	"fmt"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+// ----------------------------------------------------
+// Магнитный барабан "Сетунь-1958"
+// ----------------------------------------------------
+//
+// 36 зон по 54 коротких слова (одна страница ферритовой памяти).
+// Номер зоны задается полем A*(2:5) команд обмена с барабаном и
+// сохраняется в регистре MB(1:4); допустимые номера — 5..40.
+
+// Размеры барабана
+const (
+	DrumZones = 36
+	ZoneMin   = 5
+	ZoneMax   = ZoneMin + DrumZones - 1
+)
+
+// Устройство магнитного барабана: обмен зоны со страницей памяти
+type Drum interface {
+	ReadZone(zone int, page []ternary.Word) error
+	WriteZone(zone int, page []ternary.Word) error
+	Reset()
+}
+
+// Магнитный барабан из 36 зон
+type MagDrum struct {
+	zones [DrumZones][PageWords]ternary.Word
+	count Counters // обмены зонами
+}
+
+// Создать очищенный барабан
+func NewMagDrum() *MagDrum {
+	d := new(MagDrum)
+	d.Reset()
+	return d
+}
+
+// Очистить барабан и счетчики обменов (clean_drum)
+func (d *MagDrum) Reset() {
+	for z := range d.zones {
+		for i := range d.zones[z] {
+			d.zones[z][i] = ternary.NewWord(9)
+		}
+	}
+	d.count = Counters{}
+}
+
+// Счетчики чтений и записей зон
+func (d *MagDrum) Counters() Counters {
+	return d.count
+}
+
+func checkZone(zone int) error {
+	if zone < ZoneMin || zone > ZoneMax {
+		return fmt.Errorf("%w: drum zone %d", ErrAddress, zone)
+	}
+	return nil
+}
+
+// Прочитать зону в page (до 54 слов)
+func (d *MagDrum) ReadZone(zone int, page []ternary.Word) error {
+	if err := checkZone(zone); err != nil {
+		return err
+	}
+	d.count.Reads++
+	copy(page, d.zones[zone-ZoneMin][:])
+	return nil
+}
+
+// Записать page (до 54 слов) в зону; слова приводятся к 9 тритам
+func (d *MagDrum) WriteZone(zone int, page []ternary.Word) error {
+	if err := checkZone(zone); err != nil {
+		return err
+	}
+	if len(page) > PageWords {
+		return fmt.Errorf("%w: %d words in drum zone", ErrAddress, len(page))
+	}
+	d.count.Writes++
+	for i, w := range page {
+		d.zones[zone-ZoneMin][i] = word(w.Int64(), 9)
+	}
+	return nil
+}
+
+// Слово i зоны без учета обращения
+func (d *MagDrum) Peek(zone int, i int) (ternary.Word, error) {
+	if err := checkZone(zone); err != nil {
+		return ternary.Word{}, err
+	}
+	if i < 0 || i >= PageWords {
+		return ternary.Word{}, fmt.Errorf("%w: drum word %d", ErrAddress, i)
+	}
+	return d.zones[zone-ZoneMin][i], nil
+}
+
+// ----------------------------------------------------
+// Обмен страницы памяти с зоной барабана
+// ----------------------------------------------------
+
+// Записать страницу p памяти в зону барабана
+func (m *Machine) PageToDrum(p int, zone int) error {
+	if m.Drum == nil {
+		return ErrNoDevice
+	}
+	page, err := m.Mem.ReadPage(p)
+	if err != nil {
+		return err
+	}
+	m.MB = word(int64(zone), 4)
+	return m.Drum.WriteZone(zone, page)
+}
+
+// Прочитать зону барабана в страницу p памяти
+func (m *Machine) DrumToPage(zone int, p int) error {
+	if m.Drum == nil {
+		return ErrNoDevice
+	}
+	m.MB = word(int64(zone), 4)
+	page := make([]ternary.Word, PageWords)
+	if err := m.Drum.ReadZone(zone, page); err != nil {
+		return err
+	}
+	return m.Mem.WritePage(p, page)
+}
+
+// Обменять страницу p памяти с зоной барабана: страница записывается
+// в зону, прежнее содержимое зоны загружается в страницу
+func (m *Machine) ExchangePage(p int, zone int) error {
+	if m.Drum == nil {
+		return ErrNoDevice
+	}
+	old := make([]ternary.Word, PageWords)
+	if err := m.Drum.ReadZone(zone, old); err != nil {
+		return err
+	}
+	if err := m.PageToDrum(p, zone); err != nil {
+		return err
+	}
+	return m.Mem.WritePage(p, old)
+}
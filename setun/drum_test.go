@@ -0,0 +1,74 @@
+package setun
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+func Test_drum_zone_transfer(t *testing.T) {
+	m := New()
+	program(t, m,
+		Encode(OpDWR, -81+7, 0), // страница - => зона 7
+		Encode(OpDRD, 81+7, 0),  // зона 7 => страница +
+		Encode(OpHLT, 0, 0),
+	)
+	for i := 0; i < PageWords; i++ {
+		m.Mem.Poke(PageAddr(-1, i), word(int64(i*100-2000), 9))
+	}
+	m.Mem.ResetCounters()
+	if err := m.Run(10); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < PageWords; i++ {
+		a := PageAddr(1, i)
+		if w, _ := m.Mem.Peek(a); w.Int64() != int64(i*100-2000) {
+			t.Fatalf("(%d) = %d, want %d", a, w.Int64(), i*100-2000)
+		}
+	}
+	if m.MB.Int64() != 7 {
+		t.Errorf("MB = %s", m.MB)
+	}
+	words, pages := m.Mem.Counters()
+	if words.Reads != 3 || words.Writes != 0 || pages.Reads != 1 || pages.Writes != 1 {
+		t.Errorf("memory counters: words %+v, pages %+v", words, pages)
+	}
+	if c := m.Drum.(*MagDrum).Counters(); c.Reads != 1 || c.Writes != 1 {
+		t.Errorf("drum counters %+v", c)
+	}
+}
+
+func Test_drum_exchange_reset(t *testing.T) {
+	m := New()
+	d := m.Drum.(*MagDrum)
+	zone := make([]ternary.Word, PageWords)
+	for i := range zone {
+		zone[i] = word(int64(i+1), 9)
+	}
+	if err := d.WriteZone(ZoneMax, zone); err != nil {
+		t.Fatal(err)
+	}
+	m.Mem.Poke(PageAddr(0, 0), word(-5, 9))
+	if err := m.ExchangePage(0, ZoneMax); err != nil {
+		t.Fatal(err)
+	}
+	if w, _ := m.Mem.Peek(PageAddr(0, 0)); w.Int64() != 1 {
+		t.Errorf("page word 0 = %d, want 1", w.Int64())
+	}
+	if w, _ := d.Peek(ZoneMax, 0); w.Int64() != -5 {
+		t.Errorf("zone word 0 = %d, want -5", w.Int64())
+	}
+	for _, zone := range []int{ZoneMin - 1, ZoneMax + 1} {
+		if err := m.DrumToPage(zone, 0); !errors.Is(err, ErrAddress) {
+			t.Errorf("DrumToPage(%d) = %v", zone, err)
+		}
+	}
+	m.Reset()
+	if w, _ := d.Peek(ZoneMax, 0); w.Int64() != 0 {
+		t.Errorf("zone word after reset = %d", w.Int64())
+	}
+	if c := d.Counters(); c != (Counters{}) {
+		t.Errorf("drum counters after reset %+v", c)
+	}
+}
@@ -0,0 +1,377 @@
+/**
+ * Filename: 	machine.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+// Пакет setun — эмулятор троичной ЭВМ "Сетунь-1958".
+//
+// Числа в регистрах S и R и в длинных ячейках — 18 тритов с фиксированной
+// запятой после первого трита: значение x/3^17, |x| <= (3^18-1)/2.
+// Короткое слово (9 тритов) в арифметических командах занимает старшие
+// разряды S(1:9), младшие дополняются нулями.
+package setun
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+// Ошибки эмулятора
+var (
+	ErrOverflow = errors.New("setun: overflow")
+	ErrAddress  = errors.New("setun: bad address")
+	ErrIllegal  = errors.New("setun: illegal instruction")
+	ErrNoDevice = errors.New("setun: no device")
+	ErrHalted   = errors.New("setun: machine halted")
+)
+
+// Степень 3^n
+func pow3(n uint) int64 {
+	r := int64(1)
+	for ; n > 0; n-- {
+		r *= 3
+	}
+	return r
+}
+
+// Привести v к симметричному диапазону n тритов (по модулю 3^n)
+func wrap(v int64, n uint) int64 {
+	m := pow3(n)
+	h := (m - 1) / 2
+	r := (v + h) % m
+	if r < 0 {
+		r += m
+	}
+	return r - h
+}
+
+// Симметричное округление v/3^n до ближайшего целого
+// (отбрасывание младших n тритов)
+func round(v int64, n uint) int64 {
+	return (v - wrap(v, n)) / pow3(n)
+}
+
+// Троичное число длиной l тритов из значения (по модулю 3^l)
+func word(v int64, l uint8) ternary.Word {
+	w, _ := ternary.FromInt64(wrap(v, uint(l)), l)
+	return w
+}
+
+// Модуль наибольшего 18-тритного числа
+var sMax = (pow3(18) - 1) / 2
+
+// Адрес первой команды после сброса: первое короткое слово страницы 0
+const Start = 1
+
+// Устройство ввода-вывода: dev — номер устройства A*(2:4)
+type IO interface {
+	Input(dev int, page []ternary.Word) error
+	Output(dev int, page []ternary.Word) error
+}
+
+// ЭВМ "Сетунь-1958"
+type Machine struct {
+	// Основные регистры в порядке пульта управления
+	K ternary.Word // K(1:9)  код команды
+	F ternary.Word // F(1:5)  индекс регистр
+	C ternary.Word // C(1:5)  программный счетчик
+	W ternary.Word // W(1:1)  знак результата
+	//
+	Ph1 ternary.Word // ph1(1:1) 1 разряд переполнения
+	Ph2 ternary.Word // ph2(1:1) 2 разряд переполнения
+	S   ternary.Word // S(1:18) аккумулятор
+	R   ternary.Word // R(1:18) регистр множителя
+	MB  ternary.Word // MB(1:4) номер зоны магнитного барабана
+	// Дополнительный
+	MR ternary.Word // MR(1:9) временный регистр для обмена с памятью
+
+	Mem  *Memory // ферритовая память
+	Drum Drum    // магнитный барабан
+	IO   IO      // устройства ввода-вывода
+
+	Halted bool   // останов
+	Steps  uint64 // число выполненных команд
+}
+
+// Новая машина с ферритовой памятью и магнитным барабаном
+// после аппаратного сброса
+func New() *Machine {
+	m := &Machine{Mem: NewMemory(), Drum: NewMagDrum()}
+	m.Reset()
+	return m
+}
+
+// Аппаратный сброс: очистить память, барабан и регистры
+func (m *Machine) Reset() {
+	m.Mem.Reset()
+	if m.Drum != nil {
+		m.Drum.Reset()
+	}
+	m.K = ternary.NewWord(9)
+	m.F = ternary.NewWord(5)
+	m.C = word(Start, 5)
+	m.W = ternary.NewWord(1)
+	m.Ph1 = ternary.NewWord(1)
+	m.Ph2 = ternary.NewWord(1)
+	m.S = ternary.NewWord(18)
+	m.R = ternary.NewWord(18)
+	m.MB = ternary.NewWord(4)
+	m.MR = ternary.NewWord(9)
+	m.Halted = false
+	m.Steps = 0
+}
+
+// Установить W по знаку значения
+func (m *Machine) setW(v int64) {
+	switch {
+	case v > 0:
+		m.W = word(1, 1)
+	case v < 0:
+		m.W = word(-1, 1)
+	default:
+		m.W = ternary.NewWord(1)
+	}
+}
+
+// Записать значение в S; при выходе за 18 тритов старшие триты
+// попадают в ph1, ph2, в S остаются младшие 18 тритов
+func (m *Machine) setS(v int64) error {
+	lo := wrap(v, 18)
+	ph := round(v, 18)
+	m.Ph2 = word(ph, 1)
+	m.Ph1 = word(round(ph, 1), 1)
+	m.S = word(lo, 18)
+	m.setW(lo)
+	if v < -sMax || v > sMax {
+		m.Halted = true
+		return fmt.Errorf("%w: %d", ErrOverflow, v)
+	}
+	return nil
+}
+
+// Прочитать операнд по адресу a, выровненный к 18 тритам
+func (m *Machine) load(a int) (int64, error) {
+	w, err := m.Mem.Read(a)
+	if err != nil {
+		return 0, err
+	}
+	if w.Len() == 9 {
+		m.MR = w
+		return w.Int64() * pow3(9), nil
+	}
+	return w.Int64(), nil
+}
+
+// Записать 18-тритное значение по адресу a; в короткую ячейку
+// записываются старшие 9 тритов
+func (m *Machine) store(a int, v int64) error {
+	if IsLong(a) {
+		return m.Mem.Write(a, word(v, 18))
+	}
+	m.MR = word(round(v, 9), 9)
+	return m.Mem.Write(a, m.MR)
+}
+
+// Значение 5-тритного регистра, выровненное к 18 тритам
+func high5(w ternary.Word) int64 {
+	return w.Int64() * pow3(13)
+}
+
+// Произведение дробей a*b/3^17 с симметричным округлением
+func mulFrac(a int64, b int64) int64 {
+	return round(a*b, 17)
+}
+
+// Исполнительный адрес A* = A + mod*F (по модулю 3^5)
+func (m *Machine) effective(a int, mod int8) int {
+	return int(wrap(int64(a)+int64(mod)*m.F.Int64(), 5))
+}
+
+// Адрес команды, следующей за командой по адресу c:
+// короткие слова A(5)=-, A(5)=+ одной длинной ячейки, затем следующая
+func Next(c int) int {
+	if wrap(int64(c), 1) == -1 {
+		return int(wrap(int64(c)+2, 5))
+	}
+	return int(wrap(int64(c)+1, 5))
+}
+
+// Выполнить одну команду
+func (m *Machine) Step() error {
+	if m.Halted {
+		return ErrHalted
+	}
+	c := int(m.C.Int64())
+	if IsLong(c) {
+		m.Halted = true
+		return fmt.Errorf("%w: instruction at long address %d", ErrAddress, c)
+	}
+	k, err := m.Mem.Read(c)
+	if err != nil {
+		m.Halted = true
+		return err
+	}
+	m.K = k
+	m.C = word(int64(Next(c)), 5)
+	m.Steps++
+
+	op, a, mod := Decode(k)
+	a = m.effective(a, mod)
+	if err := m.execute(op, a); err != nil {
+		m.Halted = true
+		return err
+	}
+	return nil
+}
+
+func (m *Machine) execute(op Op, a int) error {
+	s, r := m.S.Int64(), m.R.Int64()
+	switch op {
+	case OpLDS, OpADD, OpSUB, OpMUL0, OpMULP, OpMULM, OpBMUL, OpLDR, OpLDF, OpADF, OpSHF:
+		x, err := m.load(a)
+		if err != nil {
+			return err
+		}
+		switch op {
+		case OpLDS:
+			return m.setS(x)
+		case OpADD:
+			return m.setS(s + x)
+		case OpSUB:
+			return m.setS(s - x)
+		case OpMUL0:
+			m.R = m.S
+			return m.setS(mulFrac(x, s))
+		case OpMULP:
+			return m.setS(s + mulFrac(x, r))
+		case OpMULM:
+			return m.setS(x + mulFrac(s, r))
+		case OpBMUL:
+			return m.setS(m.S.Map2(word(x, 18), ternary.Mul).Int64())
+		case OpLDR:
+			m.R = word(x, 18)
+			m.setW(x)
+		case OpLDF:
+			m.F = word(round(x, 13), 5)
+			m.setW(m.F.Int64())
+		case OpADF:
+			m.F = word(m.F.Int64()+round(x, 13), 5)
+			m.setW(m.F.Int64())
+		case OpSHF:
+			return m.setS(shift(s, int(round(x, 13))))
+		}
+	case OpHLT:
+		m.Halted = true
+	case OpJZ, OpJP, OpJM, OpJMP:
+		w := m.W.Int64()
+		if op == OpJMP || op == OpJZ && w == 0 || op == OpJP && w > 0 || op == OpJM && w < 0 {
+			m.C = word(int64(a), 5)
+		}
+	case OpSTC:
+		return m.store(a, high5(m.C))
+	case OpSTF:
+		return m.store(a, high5(m.F))
+	case OpSTR:
+		return m.store(a, r)
+	case OpSTS:
+		return m.store(a, s)
+	case OpNORM:
+		// S сдвигается влево до S(1) != 0; N — число сдвигов со знаком
+		// минус, так что сдвиг нормализованного числа на N (SHF)
+		// восстанавливает исходное
+		n := 0
+		for s != 0 && wrap(round(s, 17), 1) == 0 {
+			s *= 3
+			n--
+		}
+		if err := m.store(a, s); err != nil {
+			return err
+		}
+		return m.setS(int64(n) * pow3(13))
+	case OpIO:
+		return m.io(a)
+	case OpDWR, OpDRD:
+		return m.drum(op, a)
+	default:
+		return fmt.Errorf("%w: operation %s", ErrIllegal, op.Code())
+	}
+	return nil
+}
+
+// Сдвиг 18-тритного значения на n тритов: n > 0 влево, n < 0 вправо;
+// выдвинутые триты теряются
+func shift(v int64, n int) int64 {
+	switch {
+	case n >= 18 || n <= -18:
+		return 0
+	case n > 0:
+		return wrap(v*pow3(uint(n)), 18)
+	case n < 0:
+		return round(v, uint(-n))
+	}
+	return v
+}
+
+// Страница A*(1) и поле A*(2:5)
+func splitPage(a int) (page int, rest int) {
+	page = int(round(int64(a), 4))
+	return page, int(wrap(int64(a), 4))
+}
+
+// Ввод-вывод: A*(1) — страница, A*(2:4) — устройство,
+// A*(5) = + ввод в страницу, A*(5) = - вывод страницы
+func (m *Machine) io(a int) error {
+	if m.IO == nil {
+		return ErrNoDevice
+	}
+	p, rest := splitPage(a)
+	dir := int(wrap(int64(rest), 1))
+	dev := (rest - dir) / 3
+	switch dir {
+	case 1:
+		page := make([]ternary.Word, PageWords)
+		if err := m.IO.Input(dev, page); err != nil {
+			return err
+		}
+		return m.Mem.WritePage(p, page)
+	case -1:
+		page, err := m.Mem.ReadPage(p)
+		if err != nil {
+			return err
+		}
+		return m.IO.Output(dev, page)
+	}
+	return fmt.Errorf("%w: I/O direction 0 at %d", ErrIllegal, a)
+}
+
+// Обмен с барабаном: A*(1) — страница, A*(2:5) — зона, заносится в MB
+func (m *Machine) drum(op Op, a int) error {
+	p, zone := splitPage(a)
+	if op == OpDWR {
+		return m.PageToDrum(p, zone)
+	}
+	return m.DrumToPage(zone, p)
+}
+
+// Выполнять команды до останова, ошибки или исчерпания max команд
+// (max <= 0 — без ограничения)
+func (m *Machine) Run(max int) error {
+	for n := 0; !m.Halted; n++ {
+		if max > 0 && n >= max {
+			return nil
+		}
+		if err := m.Step(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+package setun
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+// Загрузить программу в короткие ячейки начиная с адреса Start
+func program(t *testing.T, m *Machine, code ...ternary.Word) {
+	t.Helper()
+	a := Start
+	for _, k := range code {
+		if err := m.Mem.Write(a, k); err != nil {
+			t.Fatal(err)
+		}
+		a = Next(a)
+	}
+}
+
+// Записать данные: длинное значение по длинному адресу,
+// короткое — по короткому
+func data(t *testing.T, m *Machine, a int, v int64) {
+	t.Helper()
+	l := uint8(9)
+	if IsLong(a) {
+		l = 18
+	}
+	if err := m.Mem.Write(a, word(v, l)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func read(t *testing.T, m *Machine, a int) int64 {
+	t.Helper()
+	w, err := m.Mem.Read(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w.Int64()
+}
+
+func Test_encode_decode(t *testing.T) {
+	for _, op := range Ops() {
+		for a := -AddrMax; a <= AddrMax; a++ {
+			for mod := int8(-1); mod <= 1; mod++ {
+				o, b, d := Decode(Encode(op, a, mod))
+				if o != op || b != a || d != mod {
+					t.Fatalf("Decode(Encode(%s, %d, %d)) = %s, %d, %d", op, a, mod, o, b, d)
+				}
+			}
+		}
+	}
+	if n := len(Ops()); n != 24 {
+		t.Errorf("len(Ops()) = %d, want 24", n)
+	}
+	if got := Encode(OpADD, 0, 0).String(); got != "00000+0+0" {
+		t.Errorf("Encode(ADD 0) = %s", got)
+	}
+	if op, ok := LookupOp("mul0"); !ok || op != OpMUL0 || op.Code() != "++0" {
+		t.Errorf("LookupOp(mul0) = %s, %v", op, ok)
+	}
+}
+
+func Test_memory_short_long(t *testing.T) {
+	m := NewMemory()
+	// длинная ячейка 3 = короткие 2 (старшая) и 4 (младшая)
+	if err := m.Write(3, word(5*pow3(9)-7, 18)); err != nil {
+		t.Fatal(err)
+	}
+	hi, _ := m.Read(2)
+	lo, _ := m.Read(4)
+	if hi.Int64() != 5 || lo.Int64() != -7 {
+		t.Errorf("halves of long word = %d, %d", hi.Int64(), lo.Int64())
+	}
+	if err := m.Write(AddrMax+1, word(0, 9)); !errors.Is(err, ErrAddress) {
+		t.Errorf("Write(122) error = %v", err)
+	}
+}
+
+func Test_add_sub(t *testing.T) {
+	m := New()
+	program(t, m,
+		Encode(OpLDS, -119, 0),
+		Encode(OpADD, -118, 0),
+		Encode(OpSUB, -116, 0),
+		Encode(OpSTS, -115, 0),
+		Encode(OpADD, 60, 0),
+		Encode(OpSTS, 63, 0),
+		Encode(OpHLT, 0, 0),
+	)
+	data(t, m, -119, 100)
+	data(t, m, -118, 50)
+	data(t, m, -116, -30)
+	data(t, m, 60, 12345678)
+	if err := m.Run(100); err != nil {
+		t.Fatal(err)
+	}
+	if got := read(t, m, -115); got != 180 {
+		t.Errorf("short sum = %d, want 180", got)
+	}
+	if got, want := read(t, m, 63), 180*pow3(9)+12345678; got != want {
+		t.Errorf("long sum = %d, want %d", got, want)
+	}
+	if !m.Halted || m.Steps != 7 || m.W.Int64() != 1 {
+		t.Errorf("halted %v, steps %d, W %s", m.Halted, m.Steps, m.W)
+	}
+	if err := m.Step(); !errors.Is(err, ErrHalted) {
+		t.Errorf("Step after halt = %v", err)
+	}
+}
+
+func Test_multiply(t *testing.T) {
+	third := pow3(16) // 1/3
+	m := New()
+	program(t, m,
+		Encode(OpLDS, 30, 0),  // S = 1/3
+		Encode(OpMUL0, 33, 0), // R = 1/3, S = -1/3 * 1/3
+		Encode(OpSTS, 36, 0),
+		Encode(OpMULP, 30, 0), // S = -1/9 + 1/3 * 1/3
+		Encode(OpSTS, 39, 0),
+		Encode(OpLDS, 30, 0),
+		Encode(OpMULM, 33, 0), // S = -1/3 + 1/3 * 1/3
+		Encode(OpSTS, 42, 0),
+		Encode(OpHLT, 0, 0),
+	)
+	data(t, m, 30, third)
+	data(t, m, 33, -third)
+	if err := m.Run(100); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		a    int
+		want int64
+	}{
+		{36, -pow3(15)},
+		{39, 0},
+		{42, -third + pow3(15)},
+	} {
+		if got := read(t, m, c.a); got != c.want {
+			t.Errorf("(%d) = %d, want %d", c.a, got, c.want)
+		}
+	}
+	if m.R.Int64() != third || m.W.Int64() != -1 {
+		t.Errorf("R = %d, W = %s", m.R.Int64(), m.W)
+	}
+}
+
+func Test_overflow(t *testing.T) {
+	m := New()
+	program(t, m,
+		Encode(OpLDS, 30, 0),
+		Encode(OpADD, 30, 0),
+		Encode(OpHLT, 0, 0),
+	)
+	data(t, m, 30, sMax)
+	err := m.Run(100)
+	if !errors.Is(err, ErrOverflow) {
+		t.Fatalf("Run() = %v, want overflow", err)
+	}
+	if m.Ph1.Int64() != 0 || m.Ph2.Int64() != 1 || m.S.Int64() != 2*sMax-pow3(18) {
+		t.Errorf("ph1 %s, ph2 %s, S %d", m.Ph1, m.Ph2, m.S.Int64())
+	}
+	if !m.Halted || m.Steps != 2 {
+		t.Errorf("halted %v after %d steps", m.Halted, m.Steps)
+	}
+}
+
+func Test_loop_index(t *testing.T) {
+	// сумма трех коротких чисел по адресам 10, 11, 13 (индекс F = 0, 1, 3
+	// от адреса 10) с циклом по F
+	m := New()
+	program(t, m,
+		Encode(OpLDF, -110, 0), // 1: F = 3
+		Encode(OpADD, 10, 1),   // 2: S += (10 + F)
+		Encode(OpADF, -109, 0), // 4: F -= 1
+		Encode(OpJP, 2, 0),     // 5: W = + -> 2
+		Encode(OpADD, 10, 0),   // 7: S += (10)
+		Encode(OpHLT, 0, 0),    // 8
+	)
+	data(t, m, -110, 3*81)
+	data(t, m, -109, -1*81)
+	data(t, m, 10, 1)
+	data(t, m, 11, 20)
+	data(t, m, 13, 300)
+	if err := m.Run(100); err != nil {
+		t.Fatal(err)
+	}
+	// F = 3, 2, 1: адреса 13, 12 (длинное слово из 11 и 13), 11; затем 10
+	want := (300+20+1)*pow3(9) + read(t, m, 12)
+	if got := m.S.Int64(); got != want {
+		t.Errorf("S = %d, want %d", got, want)
+	}
+	if m.F.Int64() != 0 || m.W.Int64() != 1 {
+		t.Errorf("F = %d, W = %s", m.F.Int64(), m.W)
+	}
+}
+
+func Test_jumps(t *testing.T) {
+	for _, c := range []struct {
+		v    int64
+		op   Op
+		jump bool
+	}{
+		{0, OpJZ, true}, {5, OpJZ, false},
+		{5, OpJP, true}, {-5, OpJP, false},
+		{-5, OpJM, true}, {0, OpJM, false},
+		{5, OpJMP, true},
+	} {
+		m := New()
+		program(t, m,
+			Encode(OpLDS, -119, 0), // 1
+			Encode(c.op, 7, 0),     // 2
+			Encode(OpHLT, 0, 0),    // 4
+		)
+		data(t, m, -119, c.v)
+		m.Run(2)
+		if jump := m.C.Int64() == 7; jump != c.jump {
+			t.Errorf("%s with S = %d: jump = %v", c.op, c.v, jump)
+		}
+	}
+}
+
+func Test_shift_norm(t *testing.T) {
+	m := New()
+	program(t, m,
+		Encode(OpLDS, 30, 0),
+		Encode(OpNORM, 33, 0), // (33) = 3^17, S = N = -17
+		Encode(OpSTS, -119, 0),
+		Encode(OpLDS, 33, 0),
+		Encode(OpSHF, -119, 0), // сдвиг на N восстанавливает 1
+		Encode(OpSTS, 36, 0),
+		Encode(OpBMUL, 39, 0),
+		Encode(OpHLT, 0, 0),
+	)
+	data(t, m, 30, 1)
+	data(t, m, 39, -1)
+	if err := m.Run(100); err != nil {
+		t.Fatal(err)
+	}
+	if got := read(t, m, 33); got != pow3(17) {
+		t.Errorf("normalized = %d", got)
+	}
+	if got := read(t, m, -119); got != -17*81 {
+		t.Errorf("N = %d, want %d", got, -17*81)
+	}
+	if got := read(t, m, 36); got != 1 {
+		t.Errorf("shifted back = %d", got)
+	}
+	if got := m.S.Int64(); got != -1 || m.W.Int64() != -1 {
+		t.Errorf("bitwise mul = %d, W = %s", got, m.W)
+	}
+}
+
+func Test_illegal_and_devices(t *testing.T) {
+	for _, c := range []struct {
+		k   ternary.Word
+		err error
+	}{
+		{Encode(Op(-9), 0, 0), ErrIllegal},
+		{Encode(OpIO, 1, 0), ErrNoDevice},
+		{Encode(OpDRD, 1, 0), ErrAddress}, // зона 1 вне барабана
+		{Encode(OpJMP, 3, 0), ErrAddress},
+	} {
+		m := New()
+		program(t, m, c.k, c.k)
+		err := m.Run(2)
+		if !errors.Is(err, c.err) || !m.Halted {
+			t.Errorf("%s: Run() = %v, want %v", c.k, err, c.err)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+/**
+ * Filename: 	memory.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package setun
+
+import (
+	"fmt"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+// ----------------------------------------------------
+// Ферритовая оперативная память "Сетунь-1958"
+// ----------------------------------------------------
+//
+// Адрес A(1:5) — 5 тритов, -121..121. Старшие 4 трита A(1:4) задают
+// строку (81 длинная ячейка по 18 тритов), младший трит A(5):
+//
+//	A(5) = -  короткое слово (старшая половина длинной ячейки)
+//	A(5) = 0  длинное слово из двух коротких
+//	A(5) = +  короткое слово (младшая половина длинной ячейки)
+//
+// Старший трит A(1) задает страницу из 27 строк (54 коротких слова),
+// которой память обменивается с магнитным барабаном.
+
+// Размеры памяти
+const (
+	ShortWords = 162 // коротких слов по 9 тритов
+	PageWords  = 54  // коротких слов в странице
+	Pages      = 3   // страниц (A(1) = -, 0, +)
+
+	AddrMax = 121 // (3^5-1)/2
+)
+
+// Счетчики обращений
+type Counters struct {
+	Reads  uint64 // чтения
+	Writes uint64 // записи
+}
+
+// Ферритовая память из 162 коротких слов
+// Обращения команд (Read, Write) и обмены страницами учитываются
+// счетчиками для оценки времени выполнения программы; Peek и Poke
+// (пульт, загрузчик, отладчик) счетчики не изменяют.
+type Memory struct {
+	cells [ShortWords]ternary.Word
+	words Counters // обращения к словам
+	pages Counters // обмены страницами
+}
+
+// Создать очищенную память
+func NewMemory() *Memory {
+	m := new(Memory)
+	m.Reset()
+	return m
+}
+
+// Очистить память и счетчики обращений (clean_fram)
+func (m *Memory) Reset() {
+	for i := range m.cells {
+		m.cells[i] = ternary.NewWord(9)
+	}
+	m.ResetCounters()
+}
+
+// Обнулить счетчики обращений
+func (m *Memory) ResetCounters() {
+	m.words = Counters{}
+	m.pages = Counters{}
+}
+
+// Счетчики обращений к словам и обменов страницами
+func (m *Memory) Counters() (words Counters, pages Counters) {
+	return m.words, m.pages
+}
+
+// Строка и младший трит адреса
+func splitAddr(addr int) (row int, low int) {
+	low = int(wrap(int64(addr), 1))
+	return (addr - low) / 3, low
+}
+
+// Является ли адрес адресом длинного слова
+func IsLong(addr int) bool {
+	_, low := splitAddr(addr)
+	return low == 0
+}
+
+// Номер короткого слова в массиве ячеек
+func cellIndex(row int, low int) int {
+	return (row+40)*2 + (low+1)/2
+}
+
+func checkAddr(addr int) error {
+	if addr < -AddrMax || addr > AddrMax {
+		return fmt.Errorf("%w: %d", ErrAddress, addr)
+	}
+	return nil
+}
+
+// Прочитать слово: короткое (9 тритов) или длинное (18 тритов)
+func (m *Memory) Read(addr int) (ternary.Word, error) {
+	w, err := m.Peek(addr)
+	if err == nil {
+		m.words.Reads++
+	}
+	return w, err
+}
+
+// Записать слово: в короткую ячейку — 9 тритов, в длинную — 18 тритов
+// (старшие 9 тритов в A(5) = -, младшие в A(5) = +)
+func (m *Memory) Write(addr int, w ternary.Word) error {
+	err := m.Poke(addr, w)
+	if err == nil {
+		m.words.Writes++
+	}
+	return err
+}
+
+// Read без учета обращения
+func (m *Memory) Peek(addr int) (ternary.Word, error) {
+	if err := checkAddr(addr); err != nil {
+		return ternary.Word{}, err
+	}
+	row, low := splitAddr(addr)
+	if low != 0 {
+		return m.cells[cellIndex(row, low)], nil
+	}
+	hi := m.cells[cellIndex(row, -1)].Int64()
+	lo := m.cells[cellIndex(row, 1)].Int64()
+	return word(hi*pow3(9)+lo, 18), nil
+}
+
+// Write без учета обращения
+func (m *Memory) Poke(addr int, w ternary.Word) error {
+	if err := checkAddr(addr); err != nil {
+		return err
+	}
+	row, low := splitAddr(addr)
+	v := w.Int64()
+	if low != 0 {
+		m.cells[cellIndex(row, low)] = word(v, 9)
+		return nil
+	}
+	lo := wrap(v, 9)
+	m.cells[cellIndex(row, -1)] = word((v-lo)/pow3(9), 9)
+	m.cells[cellIndex(row, 1)] = word(lo, 9)
+	return nil
+}
+
+// Первая ячейка страницы p = -1, 0, +1 в массиве ячеек
+func pageIndex(p int) (int, error) {
+	if p < -1 || p > 1 {
+		return 0, fmt.Errorf("%w: page %d", ErrAddress, p)
+	}
+	return (p + 1) * PageWords, nil
+}
+
+// Адрес короткого слова i (0..53) страницы p: слова идут парами
+// A(5) = -, A(5) = + по строкам, первое слово страницы -1 — адрес -121
+func PageAddr(p int, i int) int {
+	return p*81 - 40 + 3*(i/2) + 2*(i%2)
+}
+
+// Выдать копию страницы p (54 коротких слова в порядке адресов)
+func (m *Memory) ReadPage(p int) ([]ternary.Word, error) {
+	i, err := pageIndex(p)
+	if err != nil {
+		return nil, err
+	}
+	m.pages.Reads++
+	return append([]ternary.Word(nil), m.cells[i:i+PageWords]...), nil
+}
+
+// Записать в страницу p до 54 коротких слов; слова приводятся к 9 тритам
+func (m *Memory) WritePage(p int, page []ternary.Word) error {
+	i, err := pageIndex(p)
+	if err != nil {
+		return err
+	}
+	if len(page) > PageWords {
+		return fmt.Errorf("%w: %d words in page", ErrAddress, len(page))
+	}
+	m.pages.Writes++
+	for k, w := range page {
+		m.cells[i+k] = word(w.Int64(), 9)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+/**
+ * Filename: 	ops.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package setun
+
+import (
+	"strings"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+// ----------------------------------------------------
+// Система команд "Сетунь-1958"
+// ----------------------------------------------------
+//
+// Команда — короткое слово K(1:9):
+//
+//	K(1:5)  адрес A
+//	K(6:8)  код операции (-13..13)
+//	K(9)    признак модификации адреса: A* = A + K(9)*F
+
+// Код операции K(6:8)
+type Op int8
+
+// 24 команды "Сетунь-1958"; коды -00, --+, --- не используются
+const (
+	OpLDS  Op = 9   // +00 посылка в S       (A*)=>(S)
+	OpADD  Op = 10  // +0+ сложение в S      (S)+(A*)=>(S)
+	OpSUB  Op = 8   // +0- вычитание в S     (S)-(A*)=>(S)
+	OpMUL0 Op = 12  // ++0 умножение 0       (S)=>(R); (A*)(R)=>(S)
+	OpMULP Op = 13  // +++ умножение +       (S)+(A*)(R)=>(S)
+	OpMULM Op = 11  // ++- умножение -       (A*)+(S)(R)=>(S)
+	OpBMUL Op = 6   // +-0 поразрядное умножение (A*)[x](S)=>(S)
+	OpLDR  Op = 7   // +-+ посылка в R       (A*)=>(R)
+	OpHLT  Op = 5   // +-- останов
+	OpJZ   Op = 3   // 0+0 условный переход  W=0: A*=>(C)
+	OpJP   Op = 4   // 0++ условный переход  W=+: A*=>(C)
+	OpJM   Op = 2   // 0+- условный переход  W=-: A*=>(C)
+	OpJMP  Op = 1   // 00+ безусловный переход A*=>(C)
+	OpLDF  Op = 0   // 000 посылка в F       (A*)=>(F)
+	OpSTC  Op = -1  // 00- запись из C       (C)=>(A*)
+	OpSTF  Op = -3  // 0-0 запись из F       (F)=>(A*)
+	OpADF  Op = -2  // 0-+ сложение в F      (F)+(A*)=>(F)
+	OpSTR  Op = -4  // 0-- запись из R       (R)=>(A*)
+	OpIO   Op = -6  // -+0 ввод-вывод
+	OpSHF  Op = -5  // -++ сдвиг (S) на (A*)=>(S)
+	OpSTS  Op = -7  // -+- запись из S       (S)=>(A*)
+	OpNORM Op = -8  // -0+ нормализация      Норм.(S)=>(A*); (N)=>(S)
+	OpDWR  Op = -10 // -0- запись на барабан страница A*(1) => зона A*(2:5)
+	OpDRD  Op = -12 // --0 чтение с барабана зона A*(2:5) => страница A*(1)
+)
+
+// Описание команды
+type OpInfo struct {
+	Mnemonic string // мнемоника ассемблера
+	Text     string // операция в обозначениях пульта
+}
+
+var opTable = [27]OpInfo{
+	OpLDS + 13:  {"LDS", "(A*)=>(S)"},
+	OpADD + 13:  {"ADD", "(S)+(A*)=>(S)"},
+	OpSUB + 13:  {"SUB", "(S)-(A*)=>(S)"},
+	OpMUL0 + 13: {"MUL0", "(S)=>(R); (A*)(R)=>(S)"},
+	OpMULP + 13: {"MULP", "(S)+(A*)(R)=>(S)"},
+	OpMULM + 13: {"MULM", "(A*)+(S)(R)=>(S)"},
+	OpBMUL + 13: {"BMUL", "(A*)[x](S)=>(S)"},
+	OpLDR + 13:  {"LDR", "(A*)=>(R)"},
+	OpHLT + 13:  {"HLT", "stop"},
+	OpJZ + 13:   {"JZ", "W=0: A*=>(C)"},
+	OpJP + 13:   {"JP", "W=+: A*=>(C)"},
+	OpJM + 13:   {"JM", "W=-: A*=>(C)"},
+	OpJMP + 13:  {"JMP", "A*=>(C)"},
+	OpLDF + 13:  {"LDF", "(A*)=>(F)"},
+	OpSTC + 13:  {"STC", "(C)=>(A*)"},
+	OpSTF + 13:  {"STF", "(F)=>(A*)"},
+	OpADF + 13:  {"ADF", "(F)+(A*)=>(F)"},
+	OpSTR + 13:  {"STR", "(R)=>(A*)"},
+	OpIO + 13:   {"IO", "I/O A*"},
+	OpSHF + 13:  {"SHF", "(S) shift (A*)=>(S)"},
+	OpSTS + 13:  {"STS", "(S)=>(A*)"},
+	OpNORM + 13: {"NORM", "Norm.(S)=>(A*); (N)=>(S)"},
+	OpDWR + 13:  {"DWR", "page A*(1)=>drum A*(2:5)"},
+	OpDRD + 13:  {"DRD", "drum A*(2:5)=>page A*(1)"},
+}
+
+// Описание команды; ok = false для неиспользуемого кода
+func (op Op) Info() (OpInfo, bool) {
+	if op < -13 || op > 13 {
+		return OpInfo{}, false
+	}
+	i := opTable[op+13]
+	return i, i.Mnemonic != ""
+}
+
+// Является ли код кодом команды
+func (op Op) Valid() bool {
+	_, ok := op.Info()
+	return ok
+}
+
+// Мнемоника команды или код тритами для неиспользуемого кода
+func (op Op) String() string {
+	if i, ok := op.Info(); ok {
+		return i.Mnemonic
+	}
+	return op.Code()
+}
+
+// Код операции тритами K(6:8): "+00"
+func (op Op) Code() string {
+	return word(int64(op), 3).String()
+}
+
+// Найти команду по мнемонике (без учета регистра)
+func LookupOp(mnemonic string) (Op, bool) {
+	for i, info := range opTable {
+		if info.Mnemonic != "" && strings.EqualFold(info.Mnemonic, mnemonic) {
+			return Op(i - 13), true
+		}
+	}
+	return 0, false
+}
+
+// Все команды в порядке убывания кода
+func Ops() []Op {
+	var ops []Op
+	for op := Op(13); op >= -13; op-- {
+		if op.Valid() {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+// Собрать команду K(1:9) из адреса a (-121..121), кода op и
+// признака модификации mod (-1, 0, +1)
+func Encode(op Op, a int, mod int8) ternary.Word {
+	return word(wrap(int64(a), 5)*81+int64(op)*3+int64(mod), 9)
+}
+
+// Разобрать команду K(1:9) на код, адрес и признак модификации
+func Decode(k ternary.Word) (op Op, a int, mod int8) {
+	v := k.Int64()
+	mod = int8(wrap(v, 1))
+	v = (v - int64(mod)) / 3
+	op = Op(wrap(v, 3))
+	a = int((v - int64(op)) / 27)
+	return op, a, mod
+}
@@ -0,0 +1,412 @@
+/**
+ * Filename: 	tape.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package setun
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+// ----------------------------------------------------
+// Перфолента и телетайп
+// ----------------------------------------------------
+//
+// Программы и данные вводились в "Сетунь-1958" с 5-строчной
+// перфоленты, результаты печатались телетайпом. Строка ленты — код
+// 0..31: дорожка 1 — младший бит, дорожка 5 — старший.
+//
+// Таблица кодов символов (телеграфный код МТК-2, латинский и цифровой
+// регистры). Коды 27 (ЦИФ) и 31 (ЛАТ) переключают регистр, код 0 —
+// пустая строка (ракорд), 8 — возврат каретки, 2 — перевод строки,
+// 4 — пробел. Позиции национального использования цифрового регистра
+// (D, F, G, H) заняты символами '#', ';', '*', '%' текстов ассемблера.
+//
+//	код  лат цифр   код  лат цифр   код  лат цифр   код  лат цифр
+//	  1   E   3       9   D   #      17   Z   +      25   B   ?
+//	  3   A   -      10   R   4      18   L   )      26   G   *
+//	  5   S   '      11   J          19   W   2      28   M   .
+//	  6   I   8      12   N   ,      20   H   %      29   X   /
+//	  7   U   7      13   F   ;      21   Y   6      30   V   =
+//	                 14   C   :      22   P   0
+//	                 15   K   (      23   Q   1
+//	                 16   T   5      24   O   9
+//
+// Формат файла образа перфоленты (текст):
+//
+//	# комментарий до конца строки
+//	o.o..        одна строка ленты: 5 позиций дорожек 1..5,
+//	.o...        'o' — пробивка, '.' — нет пробивки
+//
+// Пробелы внутри строки игнорируются; Tape.WriteImage добавляет
+// к каждой строке комментарий с символом.
+
+// Управляющие коды телетайпа
+const (
+	CodeBlank   = 0  // пустая строка ленты
+	CodeLF      = 2  // перевод строки
+	CodeSpace   = 4  // пробел
+	CodeCR      = 8  // возврат каретки
+	CodeFigures = 27 // регистр цифр
+	CodeLetters = 31 // латинский регистр
+)
+
+// Ошибки ленты
+var (
+	ErrTapeFormat = errors.New("setun: bad paper tape image")
+	ErrCharacter  = errors.New("setun: character not in teletype code")
+	ErrEndOfTape  = errors.New("setun: end of paper tape")
+)
+
+// Символы латинского и цифрового регистров; 0 — нет символа
+var (
+	lettersTable = [32]byte{
+		1: 'E', 3: 'A', 5: 'S', 6: 'I', 7: 'U', 9: 'D', 10: 'R', 11: 'J',
+		12: 'N', 13: 'F', 14: 'C', 15: 'K', 16: 'T', 17: 'Z', 18: 'L', 19: 'W',
+		20: 'H', 21: 'Y', 22: 'P', 23: 'Q', 24: 'O', 25: 'B', 26: 'G', 28: 'M',
+		29: 'X', 30: 'V',
+	}
+	figuresTable = [32]byte{
+		1: '3', 3: '-', 5: '\'', 6: '8', 7: '7', 9: '#', 10: '4',
+		12: ',', 13: ';', 14: ':', 15: '(', 16: '5', 17: '+', 18: ')', 19: '2',
+		20: '%', 21: '6', 22: '0', 23: '1', 24: '9', 25: '?', 26: '*', 28: '.',
+		29: '/', 30: '=',
+	}
+)
+
+// Код символа и его регистр (CodeLetters, CodeFigures или 0 для
+// символов обоих регистров); строчные буквы кодируются как прописные
+func CharCode(c rune) (code uint8, shift uint8, ok bool) {
+	switch c {
+	case ' ':
+		return CodeSpace, 0, true
+	case '\r':
+		return CodeCR, 0, true
+	case '\n':
+		return CodeLF, 0, true
+	}
+	if c >= 'a' && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	for i := range lettersTable {
+		if lettersTable[i] != 0 && rune(lettersTable[i]) == c {
+			return uint8(i), CodeLetters, true
+		}
+		if figuresTable[i] != 0 && rune(figuresTable[i]) == c {
+			return uint8(i), CodeFigures, true
+		}
+	}
+	return 0, 0, false
+}
+
+// Перфолента: строки с кодами 0..31
+type Tape []uint8
+
+// Набить текст на ленту: перевод строки — коды CR LF, перед символом
+// другого регистра — код переключения регистра
+func Punch(text string) (Tape, error) {
+	var t Tape
+	var shift uint8
+	for i, c := range text {
+		code, sh, ok := CharCode(c)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q at %d", ErrCharacter, c, i)
+		}
+		if sh != 0 && sh != shift {
+			t = append(t, sh)
+			shift = sh
+		}
+		if code == CodeLF {
+			t = append(t, CodeCR)
+		}
+		t = append(t, code)
+	}
+	return t, nil
+}
+
+// Печатающее устройство: преобразует коды в символы с учетом регистра
+type decoder struct {
+	shift uint8
+}
+
+// Символ кода; ok = false для кодов без символа
+func (d *decoder) char(code uint8) (byte, bool) {
+	switch code &= 31; code {
+	case CodeFigures, CodeLetters:
+		d.shift = code
+	case CodeSpace:
+		return ' ', true
+	case CodeLF:
+		return '\n', true
+	default:
+		c := lettersTable[code]
+		if d.shift == CodeFigures {
+			c = figuresTable[code]
+		}
+		return c, c != 0
+	}
+	return 0, false
+}
+
+// Текст ленты; пустые строки, возврат каретки и коды без символа
+// пропускаются, начальный регистр — латинский
+func (t Tape) Text() string {
+	var sb strings.Builder
+	d := decoder{shift: CodeLetters}
+	for _, code := range t {
+		if c, ok := d.char(code); ok {
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// Прочитать образ перфоленты
+func ReadTapeImage(r io.Reader) (Tape, error) {
+	var t Tape
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		s := sc.Text()
+		if i := strings.IndexByte(s, '#'); i >= 0 {
+			s = s[:i]
+		}
+		s = strings.Join(strings.Fields(s), "")
+		if s == "" {
+			continue
+		}
+		if len(s) != 5 {
+			return nil, fmt.Errorf("%w: line %d: %q is not 5 tracks", ErrTapeFormat, line, s)
+		}
+		var code uint8
+		for i := 4; i >= 0; i-- {
+			switch s[i] {
+			case 'o':
+				code = code<<1 | 1
+			case '.':
+				code <<= 1
+			default:
+				return nil, fmt.Errorf("%w: line %d: bad track %q", ErrTapeFormat, line, s[i])
+			}
+		}
+		t = append(t, code)
+	}
+	return t, sc.Err()
+}
+
+// Записать образ перфоленты с символами в комментариях
+func (t Tape) WriteImage(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "# Setun-1958 paper tape, tracks 1..5")
+	d := decoder{shift: CodeLetters}
+	for _, code := range t {
+		var row [5]byte
+		for i := range row {
+			row[i] = '.'
+			if code>>uint(i)&1 != 0 {
+				row[i] = 'o'
+			}
+		}
+		c, ok := d.char(code)
+		comment := ""
+		switch code {
+		case CodeFigures:
+			comment = "FIGS"
+		case CodeLetters:
+			comment = "LTRS"
+		case CodeCR:
+			comment = "CR"
+		case CodeLF:
+			comment = "LF"
+		case CodeSpace:
+			comment = "SP"
+		default:
+			if ok {
+				comment = string(c)
+			}
+		}
+		if comment == "" {
+			fmt.Fprintln(bw, string(row[:]))
+		} else {
+			fmt.Fprintf(bw, "%s  # %s\n", row[:], comment)
+		}
+	}
+	return bw.Flush()
+}
+
+// Ассемблировать программу, набитую на ленте, и загрузить ее в машину
+func LoadTape(m *Machine, t Tape) (*Image, error) {
+	img, err := AssembleString(t.Text())
+	if err != nil {
+		return nil, err
+	}
+	return img, img.Load(m)
+}
+
+// ----------------------------------------------------
+// Устройства ввода-вывода
+// ----------------------------------------------------
+//
+// Команда -+0 (IO) обменивается страницей A*(1) с устройством A*(2:4);
+// каждое короткое слово страницы — одна строка ленты (код 0..31):
+//
+//	устройство 0  ввод: фотосчитыватель ленты Reader;
+//	              вывод: перфоратор, строки добавляются к Punched
+//	устройство 1  ввод: клавиатура телетайпа Keyboard (символы
+//	              кодируются с переключением регистра, ввод страницы
+//	              заканчивается переводом строки);
+//	              вывод: печать телетайпа в Printer (файл или stdout)
+//
+// При вводе неполная страница дополняется пустыми строками; ввод
+// после конца ленты — ошибка ErrEndOfTape. При выводе пустые строки
+// не печатаются и не перфорируются.
+
+// Номера устройств ввода-вывода
+const (
+	DevTape     = 0
+	DevTeletype = 1
+)
+
+// Фотосчитыватель, перфоратор и телетайп
+type Devices struct {
+	Reader   Tape      // лента фотосчитывателя
+	Punched  Tape      // лента перфоратора
+	Keyboard io.Reader // клавиатура телетайпа
+	Printer  io.Writer // печать телетайпа
+
+	pos     int     // позиция фотосчитывателя
+	pending Tape    // коды клавиатуры, не вошедшие в страницу
+	shift   uint8   // регистр клавиатуры
+	printer decoder // регистр печати
+}
+
+// Устройства с лентой фотосчитывателя tape и печатью в printer
+func NewDevices(tape Tape, printer io.Writer) *Devices {
+	return &Devices{Reader: tape, Printer: printer, printer: decoder{shift: CodeLetters}}
+}
+
+// Ввод страницы с устройства dev
+func (d *Devices) Input(dev int, page []ternary.Word) error {
+	var src func() (uint8, bool, error)
+	switch dev {
+	case DevTape:
+		if d.pos >= len(d.Reader) {
+			return ErrEndOfTape
+		}
+		src = func() (uint8, bool, error) {
+			if d.pos >= len(d.Reader) {
+				return 0, false, nil
+			}
+			d.pos++
+			return d.Reader[d.pos-1], true, nil
+		}
+	case DevTeletype:
+		if d.Keyboard == nil {
+			return ErrNoDevice
+		}
+		src = d.key
+	default:
+		return fmt.Errorf("%w: input device %d", ErrNoDevice, dev)
+	}
+	line := false // введена строка клавиатуры до перевода строки
+	for i := range page {
+		code, ok := uint8(CodeBlank), false
+		if !line {
+			var err error
+			if code, ok, err = src(); err != nil {
+				return err
+			}
+		}
+		if !ok {
+			code = CodeBlank
+		}
+		line = line || dev == DevTeletype && code == CodeLF
+		page[i] = word(int64(code), 9)
+	}
+	return nil
+}
+
+// Следующий код клавиатуры телетайпа
+func (d *Devices) key() (uint8, bool, error) {
+	if len(d.pending) == 0 {
+		var b [1]byte
+		n, err := d.Keyboard.Read(b[:])
+		if n == 0 {
+			if err == io.EOF {
+				err = nil
+			}
+			return 0, false, err
+		}
+		code, sh, ok := CharCode(rune(b[0]))
+		if !ok {
+			return 0, false, fmt.Errorf("%w: %q", ErrCharacter, b[0])
+		}
+		if sh != 0 && sh != d.shift {
+			d.pending = append(d.pending, sh)
+			d.shift = sh
+		}
+		if code == CodeLF {
+			d.pending = append(d.pending, CodeCR)
+		}
+		d.pending = append(d.pending, code)
+	}
+	code := d.pending[0]
+	d.pending = d.pending[1:]
+	return code, true, nil
+}
+
+// Код строки ленты из короткого слова страницы
+func pageCode(w ternary.Word) (uint8, error) {
+	v := w.Int64()
+	if v < 0 || v > 31 {
+		return 0, fmt.Errorf("%w: word %d is not a tape code", ErrCharacter, v)
+	}
+	return uint8(v), nil
+}
+
+// Вывод страницы на устройство dev
+func (d *Devices) Output(dev int, page []ternary.Word) error {
+	switch dev {
+	case DevTape:
+		for _, w := range page {
+			code, err := pageCode(w)
+			if err != nil {
+				return err
+			}
+			if code != CodeBlank {
+				d.Punched = append(d.Punched, code)
+			}
+		}
+		return nil
+	case DevTeletype:
+		if d.Printer == nil {
+			return ErrNoDevice
+		}
+		var buf []byte
+		for _, w := range page {
+			code, err := pageCode(w)
+			if err != nil {
+				return err
+			}
+			if c, ok := d.printer.char(code); ok {
+				buf = append(buf, c)
+			}
+		}
+		_, err := d.Printer.Write(buf)
+		return err
+	}
+	return fmt.Errorf("%w: output device %d", ErrNoDevice, dev)
+}
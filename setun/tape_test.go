@@ -0,0 +1,175 @@
+package setun
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+func Test_punch_text(t *testing.T) {
+	tape, err := Punch("Lds x+2,-\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Tape{CodeLetters, 18, 9, 5, CodeSpace, 29, CodeFigures, 17, 19, 12, 3, CodeCR, CodeLF}
+	if !bytes.Equal(tape, want) {
+		t.Errorf("Punch() = %v, want %v", tape, want)
+	}
+	if got := tape.Text(); got != "LDS X+2,-\n" {
+		t.Errorf("Text() = %q", got)
+	}
+	for _, s := range []string{"a_b", "@", "Сетунь"} {
+		if _, err := Punch(s); !errors.Is(err, ErrCharacter) {
+			t.Errorf("Punch(%q) = %v", s, err)
+		}
+	}
+	// все символы таблицы кодируются однозначно
+	for code := 0; code < 32; code++ {
+		for _, table := range [][32]byte{lettersTable, figuresTable} {
+			if c := table[code]; c != 0 {
+				if got, _, _ := CharCode(rune(c)); int(got) != code {
+					t.Errorf("CharCode(%q) = %d, want %d", c, got, code)
+				}
+			}
+		}
+	}
+}
+
+func Test_tape_image(t *testing.T) {
+	tape, _ := Punch("HLT 0\n")
+	var b bytes.Buffer
+	if err := tape.WriteImage(&b); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(b.String(), "\n..o.o  # H\n") {
+		t.Errorf("image:\n%s", b.String())
+	}
+	back, err := ReadTapeImage(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(back, tape) {
+		t.Errorf("ReadTapeImage() = %v, want %v", back, tape)
+	}
+	for _, img := range []string{"o.o.", "o.o.x", "oo oo o."} {
+		if _, err := ReadTapeImage(strings.NewReader(img)); !errors.Is(err, ErrTapeFormat) {
+			t.Errorf("ReadTapeImage(%q) = %v", img, err)
+		}
+	}
+}
+
+// Программа копирования ленты: ввод страницы с фотосчитывателя,
+// печать ее телетайпом и перфорация
+const copyProgram = `
+IN:    EQU -81+1      ; PAGE -, DEVICE 0, INPUT
+       IO  IN
+       IO  -81+3-1    ; PAGE -, DEVICE 1, OUTPUT
+       IO  -81-1      ; PAGE -, DEVICE 0, OUTPUT
+       HLT
+`
+
+func Test_tape_program(t *testing.T) {
+	src, err := Punch(copyProgram)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// лента программы проходит через файл образа
+	var b bytes.Buffer
+	src.WriteImage(&b)
+	src, err = ReadTapeImage(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := New()
+	if _, err := LoadTape(m, src); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := Punch("SETUN 1958\n")
+	var out bytes.Buffer
+	dev := NewDevices(data, &out)
+	m.IO = dev
+	if err := m.Run(100); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "SETUN 1958\n" {
+		t.Errorf("printed %q", out.String())
+	}
+	if !bytes.Equal(dev.Punched, data) {
+		t.Errorf("punched %v, want %v", dev.Punched, data)
+	}
+	// повторный ввод после конца ленты
+	m.Halted = false
+	m.C = word(Start, 5)
+	if err := m.Run(100); !errors.Is(err, ErrEndOfTape) {
+		t.Errorf("Run() after end of tape = %v", err)
+	}
+}
+
+func Test_teletype_keyboard(t *testing.T) {
+	var out bytes.Buffer
+	dev := NewDevices(nil, &out)
+	dev.Keyboard = strings.NewReader("ab 12\n")
+	page := make([]ternary.Word, PageWords)
+	if err := dev.Input(DevTeletype, page); err != nil {
+		t.Fatal(err)
+	}
+	// LTRS A B SP FIGS 1 2 CR LF, далее пустые строки
+	if got := page[4].Int64(); got != CodeFigures {
+		t.Errorf("page[4] = %d, want FIGS", got)
+	}
+	if err := dev.Output(DevTeletype, page); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "AB 12\n" {
+		t.Errorf("printed %q", out.String())
+	}
+	page[0] = word(-1, 9)
+	if err := dev.Output(DevTeletype, page); !errors.Is(err, ErrCharacter) {
+		t.Errorf("Output(-1) = %v", err)
+	}
+	if err := dev.Input(DevTape, page); !errors.Is(err, ErrEndOfTape) {
+		t.Errorf("Input(empty tape) = %v", err)
+	}
+	if err := dev.Input(5, page); !errors.Is(err, ErrNoDevice) {
+		t.Errorf("Input(5) = %v", err)
+	}
+}
+
+// Клавиатура: строки текста, далее чтение ошибочно (ожидание ввода)
+type keyboard struct {
+	lines *strings.Reader
+}
+
+func (k keyboard) Read(p []byte) (int, error) {
+	if k.lines.Len() == 0 {
+		return 0, errors.New("keyboard: read blocks")
+	}
+	return k.lines.Read(p)
+}
+
+func Test_teletype_short_line(t *testing.T) {
+	dev := NewDevices(nil, nil)
+	dev.Keyboard = keyboard{strings.NewReader("go\nx\n")}
+	page := make([]ternary.Word, PageWords)
+	for _, want := range []string{"GO\n", "X\n"} {
+		if err := dev.Input(DevTeletype, page); err != nil {
+			t.Fatal(err)
+		}
+		var tape Tape
+		for i, w := range page {
+			tape = append(tape, uint8(w.Int64()))
+			if i > 5 && w.Int64() != CodeBlank {
+				t.Errorf("page[%d] = %d after end of line", i, w.Int64())
+			}
+		}
+		if got := tape.Text(); got != want {
+			t.Errorf("line %q, want %q", got, want)
+		}
+	}
+	if err := dev.Input(DevTeletype, page); err == nil {
+		t.Errorf("Input() after last line: no error")
+	}
+}
@@ -0,0 +1,127 @@
+/**
+ * Filename: 	adder.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package ternary
+
+// ----------------------------------------------------
+// Сумматор с параллельным префиксным переносом
+// (Kogge-Stone для симметричной троичной системы)
+// ----------------------------------------------------
+//
+// В разряде i сумма тритов h = a[i] + b[i] лежит в -2..2 и задает
+// функцию переноса c[i+1] = f(c[i]):
+//
+//	--+-------------
+//	h | c=-  c=0  c=+
+//	--+-------------
+//	-2|  -    -    0
+//	-1|  -    0    0
+//	 0|  0    0    0
+//	+1|  0    0    +
+//	+2|  0    +    +
+//	--+-------------
+//
+// Композиция таких функций ассоциативна, поэтому переносы во всех
+// разрядах вычисляются за log2(TritsMax) = 5 шагов префиксной схемы.
+// Каждая функция хранится как три трита f(-), f(0), f(+) в битовых
+// масках (+) и (-), по одному биту на разряд.
+
+// Триты всех разрядов числа: маски (+) и (-)
+type tritPlanes struct {
+	p, n uint32
+}
+
+// Функции переноса всех разрядов числа: f(-), f(0), f(+)
+type carryMap struct {
+	m, z, p tritPlanes
+}
+
+// Применить функции g поразрядно к тритам c
+func (g carryMap) apply(c tritPlanes) tritPlanes {
+	z := ^(c.p | c.n)
+	return tritPlanes{
+		p: c.p&g.p.p | c.n&g.m.p | z&g.z.p,
+		n: c.p&g.p.n | c.n&g.m.n | z&g.z.n,
+	}
+}
+
+// Сдвинуть функции переноса на d разрядов вверх,
+// в младшие d разрядов записывается тождественная функция
+func (f carryMap) shift(d uint) carryMap {
+	low := uint32(1)<<d - 1
+	return carryMap{
+		m: tritPlanes{p: f.m.p << d, n: f.m.n<<d | low},
+		z: tritPlanes{p: f.z.p << d, n: f.z.n << d},
+		p: tritPlanes{p: f.p.p<<d | low, n: f.p.n << d},
+	}
+}
+
+// Троичное сложение троичных чисел с входным переносом p0
+// sy = +1 сложение, sy = -1 вычитание
+// Возврат: сумма и выходной перенос из старшего трита
+func addTrs(x Word, y Word, sy int8, p0 int8) (Word, int8) {
+	l := maxLen(x, y)
+	if l == 0 {
+		return Word{}, p0
+	}
+	ap, an, az := x.planes(l)
+	bp, bn, bz := y.planes(l)
+	if sy < 0 {
+		bp, bn = bn, bp
+	}
+
+	// классы суммы h = a + b
+	h2p := ap & bp
+	h2n := an & bn
+	h1p := ap&bz | az&bp
+	h1n := an&bz | az&bn
+
+	// функции переноса разрядов
+	g := carryMap{
+		m: tritPlanes{n: h2n | h1n},
+		z: tritPlanes{p: h2p, n: h2n},
+		p: tritPlanes{p: h1p | h2p},
+	}
+
+	// префиксная композиция: g[i] = f[i](f[i-1](...f[0]))
+	for d := uint(1); d < uint(l); d <<= 1 {
+		f := g.shift(d)
+		g = carryMap{m: g.apply(f.m), z: g.apply(f.z), p: g.apply(f.p)}
+	}
+
+	// переносы в разряды: c[0] = p0, c[i] = g[i-1](p0)
+	var c0 tritPlanes
+	if p0 > 0 {
+		c0.p = ^uint32(0)
+	} else if p0 < 0 {
+		c0.n = ^uint32(0)
+	}
+	c := g.apply(c0)
+	cp := c.p<<1 | c0.p&1
+	cn := c.n<<1 | c0.n&1
+	top := uint32(1) << (l - 1)
+	var carry int8
+	if c.p&top != 0 {
+		carry = 1
+	} else if c.n&top != 0 {
+		carry = -1
+	}
+
+	// сумма по модулю 3: s = a + b + c
+	sp := az&bp | ap&bz | an&bn
+	sn := az&bn | an&bz | ap&bp
+	sz := ^(sp | sn)
+	cz := ^(cp | cn)
+	rp := sz&cp | sp&cz | sn&cn
+	rn := sz&cn | sn&cz | sp&cp
+	return fromPlanes(l, rp, rn), carry
+}
@@ -0,0 +1,126 @@
+/**
+ * Filename: 	alphabet.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package ternary
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Алфавит троичной симметричной системы счисления (Таб.1)
+type Alphabet struct {
+	Name string    // название алфавита
+	Symb [3]string // символы тритов -1, 0, 1
+	Sep  string    // разделитель символов ("" — символы без разделителя)
+}
+
+// Алфавиты Таб.1
+var (
+	AlphabetNumb   = Alphabet{"numb", [3]string{"-1", "0", "1"}, " "}
+	AlphabetLogic  = Alphabet{"logic", [3]string{"false", "nil", "true"}, " "}
+	AlphabetFNT    = Alphabet{"fnt", [3]string{"f", "n", "t"}, ""}
+	AlphabetTrit   = Alphabet{"trit", [3]string{"-", "0", "+"}, ""}
+	AlphabetNZP    = Alphabet{"nzp", [3]string{"N", "Z", "P"}, ""}
+	AlphabetNOP    = Alphabet{"nop", [3]string{"N", "O", "P"}, ""}
+	Alphabet0i1    = Alphabet{"0i1", [3]string{"0", "i", "1"}, ""}
+	AlphabetArrows = Alphabet{"arrows", [3]string{"v", "0", "^"}, ""}
+)
+
+// Дополнительные алфавиты методов SymbLogic и SymbChar
+var (
+	AlphabetLogicPercent = Alphabet{"%logic", [3]string{"%false", "%nil", "%true"}, " "}
+	AlphabetMNP          = Alphabet{"mnp", [3]string{"M", "N", "P"}, ""}
+)
+
+// Все известные алфавиты
+var Alphabets = []Alphabet{
+	AlphabetNumb, AlphabetLogic, AlphabetFNT, AlphabetTrit, AlphabetNZP,
+	AlphabetNOP, Alphabet0i1, AlphabetArrows, AlphabetLogicPercent, AlphabetMNP,
+}
+
+// Ошибка разбора строки троичного числа
+var ErrSyntax = errors.New("ternary: invalid syntax")
+
+// Символ трита t в алфавите
+func (a Alphabet) symb(t int8) string {
+	return a.Symb[t+1]
+}
+
+// Значение символа в алфавите
+func (a Alphabet) value(s string) (int8, bool) {
+	for i, c := range a.Symb {
+		if c == s {
+			return int8(i) - 1, true
+		}
+	}
+	return 0, false
+}
+
+// Разбить строку на символы тритов
+func (a Alphabet) split(s string) []string {
+	if a.Sep == "" {
+		return strings.Split(s, "")
+	}
+	if strings.TrimSpace(a.Sep) == "" {
+		return strings.Fields(s)
+	}
+	return strings.Split(s, a.Sep)
+}
+
+// Метод вернуть символ трита в алфавите
+func (t Trit) Symb(a Alphabet) string {
+	return a.symb(t.ToInt())
+}
+
+// Разобрать символ трита в алфавите
+func ParseTrit(s string, a Alphabet) (Trit, error) {
+	v, ok := a.value(s)
+	if !ok {
+		return Trit{}, fmt.Errorf("%w: symbol %q is not in alphabet %s", ErrSyntax, s, a.Name)
+	}
+	return IntToTrit(v), nil
+}
+
+// Представление троичного числа в алфавите (старший трит слева)
+// Число символов равно длине числа, поэтому ParseWord(x.Text(a), a) == x.
+func (x Word) Text(a Alphabet) string {
+	x = x.norm()
+	symbs := make([]string, x.l)
+	for i := range symbs {
+		symbs[i] = a.symb(trs2int(x, x.l-1-uint8(i)))
+	}
+	return strings.Join(symbs, a.Sep)
+}
+
+// Разобрать троичное число в алфавите (старший трит слева)
+// Длина результата равна числу символов в строке; пустая строка —
+// число нулевой длины.
+func ParseWord(s string, a Alphabet) (Word, error) {
+	symbs := a.split(s)
+	if len(symbs) == 0 || s == "" {
+		return Word{}, nil
+	}
+	if len(symbs) > TritsMax {
+		return Word{}, fmt.Errorf("%w: %d trits exceed %d", ErrRange, len(symbs), TritsMax)
+	}
+	r := NewWord(uint8(len(symbs)))
+	for i, c := range symbs {
+		v, ok := a.value(c)
+		if !ok {
+			return Word{}, fmt.Errorf("%w: symbol %q at position %d is not in alphabet %s", ErrSyntax, c, i, a.Name)
+		}
+		r = int2trs(r, r.l-1-uint8(i), v)
+	}
+	return r, nil
+}
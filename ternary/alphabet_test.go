@@ -0,0 +1,53 @@
+package ternary
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_alphabet_roundtrip(t *testing.T) {
+	x, _ := FromInt64(-1234567, 18)
+	for _, x := range []Word{x, NewWord(0)} {
+		for _, a := range Alphabets {
+			s := x.Text(a)
+			y, err := ParseWord(s, a)
+			if err != nil {
+				t.Fatalf("ParseWord(%q, %s): %v", s, a.Name, err)
+			}
+			if y != x {
+				t.Errorf("ParseWord(%q, %s) = %s, want %s", s, a.Name, y.Text(AlphabetTrit), x.Text(AlphabetTrit))
+			}
+		}
+	}
+}
+
+func Test_alphabet_parse(t *testing.T) {
+	for _, c := range []struct {
+		s string
+		a Alphabet
+		n int64
+	}{
+		{"+0-", AlphabetTrit, 8},
+		{"1 0 -1", AlphabetNumb, 8},
+		{"true nil false", AlphabetLogic, 8},
+		{"tnf", AlphabetFNT, 8},
+		{"PZN", AlphabetNZP, 8},
+		{"POP", AlphabetNOP, 10},
+		{"1i0", Alphabet0i1, 8},
+		{"^0v", AlphabetArrows, 8},
+		{"%false %nil", AlphabetLogicPercent, -3},
+	} {
+		x, err := ParseWord(c.s, c.a)
+		if err != nil || x.Int64() != c.n {
+			t.Errorf("ParseWord(%q, %s) = %d, %v; want %d", c.s, c.a.Name, x.Int64(), err, c.n)
+		}
+	}
+	for _, s := range []string{"+x-", "+0-+0-+0-+0-+0-+0-+0-+0-+0-+0-+0-+"} {
+		if _, err := ParseWord(s, AlphabetTrit); err == nil {
+			t.Errorf("ParseWord(%q): no error", s)
+		}
+	}
+	if _, err := ParseWord("+x-", AlphabetTrit); !errors.Is(err, ErrSyntax) {
+		t.Errorf("ParseWord(+x-): err = %v, want ErrSyntax", err)
+	}
+}
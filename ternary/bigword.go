@@ -0,0 +1,448 @@
+/**
+ * Filename: 	bigword.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package ternary
+
+import (
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// ----------------------------------------------------
+// BIG-TRITS
+// ----------------------------------------------------
+
+// Троичное число произвольной длины (аналог math/big.Int)
+// Триты хранятся в двух битовых полях, как в Word:
+// if t0[i] == 0 then trit[i] = NIL
+// if (t0[i] == 1) && (t1[i] == 0) then trit[i] = FALSE
+// if (t0[i] == 1) && (t1[i] == 1) then trit[i] = TRUE
+// Нулевое значение BigWord равно 0 и готово к использованию.
+type BigWord struct {
+	t1 []uint64 // двоичное битовое поле троичного числа {FALSE,TRUE}
+	t0 []uint64 // двоичное битовое поле троичного числа {NIL}
+}
+
+// Число тритов в одном машинном слове битового поля
+const bigTrits = 64
+
+// Создать троичное число из int64
+func NewBigWord(n int64) *BigWord {
+	return new(BigWord).SetInt64(n)
+}
+
+// Прочитать трит в позиции i
+func (z *BigWord) Trit(i int) int8 {
+	w, b := i/bigTrits, uint(i%bigTrits)
+	if i < 0 || w >= len(z.t0) {
+		return 0
+	}
+	if z.t0[w]&(1<<b) == 0 {
+		return 0
+	}
+	if z.t1[w]&(1<<b) == 0 {
+		return -1
+	}
+	return 1
+}
+
+// Установить трит в позиции i (без нормализации)
+func (z *BigWord) setTrit(i int, t int8) {
+	w, b := i/bigTrits, uint(i%bigTrits)
+	for w >= len(z.t0) {
+		z.t0 = append(z.t0, 0)
+		z.t1 = append(z.t1, 0)
+	}
+	switch {
+	case t > 0:
+		z.t1[w] |= 1 << b
+		z.t0[w] |= 1 << b
+	case t < 0:
+		z.t1[w] &^= 1 << b
+		z.t0[w] |= 1 << b
+	default:
+		z.t1[w] &^= 1 << b
+		z.t0[w] &^= 1 << b
+	}
+}
+
+// Отбросить старшие нулевые слова
+func (z *BigWord) norm() *BigWord {
+	n := len(z.t0)
+	for n > 0 && z.t0[n-1] == 0 {
+		n--
+	}
+	z.t0 = z.t0[:n]
+	z.t1 = z.t1[:n]
+	return z
+}
+
+// Длина троичного числа в тритах (без старших нулей)
+func (z *BigWord) Len() int {
+	n := len(z.t0)
+	if n == 0 {
+		return 0
+	}
+	top := z.t0[n-1]
+	l := (n - 1) * bigTrits
+	for top != 0 {
+		top >>= 1
+		l++
+	}
+	return l
+}
+
+// Знак числа: -1, 0, +1
+func (z *BigWord) Sign() int {
+	l := z.Len()
+	if l == 0 {
+		return 0
+	}
+	return int(z.Trit(l - 1))
+}
+
+// z = x
+func (z *BigWord) Set(x *BigWord) *BigWord {
+	if z != x {
+		z.t0 = append(z.t0[:0], x.t0...)
+		z.t1 = append(z.t1[:0], x.t1...)
+	}
+	return z
+}
+
+// z = n
+func (z *BigWord) SetInt64(n int64) *BigWord {
+	z.t0 = z.t0[:0]
+	z.t1 = z.t1[:0]
+	for i := 0; n != 0; i++ {
+		r := n % 3
+		n /= 3
+		if r == 2 {
+			r = -1
+			n++
+		} else if r == -2 {
+			r = 1
+			n--
+		}
+		z.setTrit(i, int8(r))
+	}
+	return z.norm()
+}
+
+// Значение числа как int64 (результат не определен, если !IsInt64())
+func (z *BigWord) Int64() int64 {
+	var r int64
+	for i := z.Len() - 1; i >= 0; i-- {
+		r = r*3 + int64(z.Trit(i))
+	}
+	return r
+}
+
+// Помещается ли число в int64
+func (z *BigWord) IsInt64() bool {
+	return z.BigInt().IsInt64()
+}
+
+// z = x (*big.Int)
+func (z *BigWord) SetBigInt(x *big.Int) *BigWord {
+	z.t0 = z.t0[:0]
+	z.t1 = z.t1[:0]
+	n := new(big.Int).Set(x)
+	r := new(big.Int)
+	three := big.NewInt(3)
+	for i := 0; n.Sign() != 0; i++ {
+		n.QuoRem(n, three, r)
+		d := r.Int64()
+		if d == 2 {
+			d = -1
+			n.Add(n, big.NewInt(1))
+		} else if d == -2 {
+			d = 1
+			n.Sub(n, big.NewInt(1))
+		}
+		z.setTrit(i, int8(d))
+	}
+	return z.norm()
+}
+
+// Значение числа как *big.Int
+func (z *BigWord) BigInt() *big.Int {
+	r := new(big.Int)
+	three := big.NewInt(3)
+	for i := z.Len() - 1; i >= 0; i-- {
+		r.Mul(r, three)
+		r.Add(r, big.NewInt(int64(z.Trit(i))))
+	}
+	return r
+}
+
+// z = w (троичное число Word)
+func (z *BigWord) SetWord(w Word) *BigWord {
+	z.t0 = z.t0[:0]
+	z.t1 = z.t1[:0]
+	w = w.norm()
+	if w.t0 != 0 {
+		z.t0 = append(z.t0, uint64(w.t0))
+		z.t1 = append(z.t1, uint64(w.t1))
+	}
+	return z
+}
+
+// Выделить l тритов, начиная с позиции p, в троичное число Word
+func (z *BigWord) wordAt(p int, l uint8) Word {
+	r := NewWord(l)
+	for i := uint8(0); i < r.l; i++ {
+		r = int2trs(r, i, z.Trit(p+int(i)))
+	}
+	return r
+}
+
+// z = -x
+func (z *BigWord) Neg(x *BigWord) *BigWord {
+	z.Set(x)
+	for i := range z.t0 {
+		z.t1[i] = z.t0[i] &^ z.t1[i]
+	}
+	return z
+}
+
+// z = |x|
+func (z *BigWord) Abs(x *BigWord) *BigWord {
+	if x.Sign() < 0 {
+		return z.Neg(x)
+	}
+	return z.Set(x)
+}
+
+// Сравнить x и y: -1 если x < y, 0 если x == y, +1 если x > y
+// В симметричной системе старший различающийся трит определяет результат.
+func (x *BigWord) Cmp(y *BigWord) int {
+	n := x.Len()
+	if l := y.Len(); l > n {
+		n = l
+	}
+	for i := n - 1; i >= 0; i-- {
+		a, b := x.Trit(i), y.Trit(i)
+		if a > b {
+			return 1
+		}
+		if a < b {
+			return -1
+		}
+	}
+	return 0
+}
+
+// Половина k машинных слов битовых полей (триты 32k..32k+31)
+// как троичное число Word длиной TritsMax
+func (z *BigWord) half(k int) Word {
+	w, sh := k/2, uint(k%2)*TritsMax
+	if w >= len(z.t0) {
+		return NewWord(TritsMax)
+	}
+	return Word{l: TritsMax, t1: uint32(z.t1[w] >> sh), t0: uint32(z.t0[w] >> sh)}
+}
+
+// Сложение со знаком второго операнда s = +1 или -1: сумматор addTrs
+// битовых полей Word по половинам машинных слов с передачей переноса
+func (z *BigWord) addSigned(x, y *BigWord, s int8) *BigWord {
+	n := len(x.t0)
+	if len(y.t0) > n {
+		n = len(y.t0)
+	}
+	r := BigWord{t1: make([]uint64, n+1), t0: make([]uint64, n+1)}
+	var p int8
+	for k := 0; k < 2*n; k++ {
+		var h Word
+		h, p = addTrs(x.half(k), y.half(k), s, p)
+		w, sh := k/2, uint(k%2)*TritsMax
+		r.t1[w] |= uint64(h.t1) << sh
+		r.t0[w] |= uint64(h.t0) << sh
+	}
+	if p != 0 {
+		r.setTrit(n*bigTrits, p)
+	}
+	z.t0, z.t1 = r.t0, r.t1
+	return z.norm()
+}
+
+// z = x + y
+func (z *BigWord) Add(x, y *BigWord) *BigWord {
+	return z.addSigned(x, y, 1)
+}
+
+// z = x - y
+func (z *BigWord) Sub(x, y *BigWord) *BigWord {
+	return z.addSigned(x, y, -1)
+}
+
+// z = x * 3^n (сдвиг битовых полей влево на n тритов)
+func (z *BigWord) Lsh(x *BigWord, n uint) *BigWord {
+	w, b := int(n/bigTrits), n%bigTrits
+	m := len(x.t0)
+	t1 := make([]uint64, m+w+1)
+	t0 := make([]uint64, m+w+1)
+	for i := 0; i < m; i++ {
+		t1[i+w] |= x.t1[i] << b
+		t0[i+w] |= x.t0[i] << b
+		if b != 0 {
+			t1[i+w+1] |= x.t1[i] >> (bigTrits - b)
+			t0[i+w+1] |= x.t0[i] >> (bigTrits - b)
+		}
+	}
+	z.t0, z.t1 = t0, t1
+	return z.norm()
+}
+
+// z = x * y: сумма сдвигов x по ненулевым тритам y
+func (z *BigWord) Mul(x, y *BigWord) *BigWord {
+	var r, s BigWord
+	for w := range y.t0 {
+		for m := y.t0[w]; m != 0; m &= m - 1 {
+			b := bits.TrailingZeros64(m)
+			s.Lsh(x, uint(w*bigTrits+b))
+			if y.t1[w]&(1<<uint(b)) != 0 {
+				r.Add(&r, &s)
+			} else {
+				r.Sub(&r, &s)
+			}
+		}
+	}
+	z.t0, z.t1 = r.t0, r.t1
+	return z
+}
+
+// Деление с симметричным остатком: x = q*y + r, |r| <= |y|/2
+// На каждом шаге выбирается трит частного, минимизирующий |r|.
+func quoRemSym(x, y *BigWord) (q, r *BigWord) {
+	if y.Sign() == 0 {
+		panic("division by zero")
+	}
+	var b, b2, r2 BigWord
+	b.Abs(y)
+	q = new(BigWord)
+	r = new(BigWord).Set(x)
+	n := x.Len() - b.Len() + 1
+	if n < 0 {
+		n = 0
+	}
+	for i := n; i >= 0; i-- {
+		b2.Lsh(&b, uint(i))
+		r2.Add(r, r)
+		if r2.Cmp(&b2) > 0 {
+			r.Sub(r, &b2)
+			q.setTrit(i, 1)
+		} else if r2.Neg(&r2).Cmp(&b2) > 0 {
+			r.Add(r, &b2)
+			q.setTrit(i, -1)
+		}
+	}
+	q.norm()
+	if y.Sign() < 0 {
+		q.Neg(q)
+	}
+	return q, r
+}
+
+// Деление с усечением (как math/big.Int.QuoRem):
+// z = x / y с отбрасыванием дробной части, r = x - y*z.
+// Знак остатка совпадает со знаком делимого.
+// При y == 0 возникает паника.
+func (z *BigWord) QuoRem(x, y, r *BigWord) (*BigWord, *BigWord) {
+	q, m := quoRemSym(x, y)
+	if m.Sign() != 0 && m.Sign() != x.Sign() {
+		var b BigWord
+		b.Abs(y)
+		one := NewBigWord(int64(y.Sign()))
+		if m.Sign() < 0 {
+			m.Add(m, &b)
+			q.Sub(q, one)
+		} else {
+			m.Sub(m, &b)
+			q.Add(q, one)
+		}
+	}
+	z.Set(q)
+	r.Set(m)
+	return z, r
+}
+
+// Разобрать строку: base 3 — триты '-','0','+' (старший слева),
+// base 10 — десятичное число со знаком.
+func (z *BigWord) SetString(s string, base int) (*BigWord, bool) {
+	switch base {
+	case 3:
+		if s == "" {
+			return nil, false
+		}
+		var r BigWord
+		for i, c := range []byte(s) {
+			p := len(s) - 1 - i
+			switch c {
+			case '+':
+				r.setTrit(p, 1)
+			case '-':
+				r.setTrit(p, -1)
+			case '0':
+			default:
+				return nil, false
+			}
+		}
+		z.t0, z.t1 = r.t0, r.t1
+		return z.norm(), true
+	case 10:
+		n, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, false
+		}
+		return z.SetBigInt(n), true
+	}
+	return nil, false
+}
+
+// Представление числа в системе base (3 — триты '-','0','+', 10 — десятичное)
+// При другом основании возникает паника.
+func (z *BigWord) Text(base int) string {
+	if z == nil {
+		return "<nil>"
+	}
+	switch base {
+	case 3:
+	case 10:
+		return z.BigInt().String()
+	default:
+		panic("ternary: BigWord.Text: invalid base " + strconv.Itoa(base))
+	}
+	l := z.Len()
+	if l == 0 {
+		return "0"
+	}
+	var sb strings.Builder
+	for i := l - 1; i >= 0; i-- {
+		switch z.Trit(i) {
+		case 1:
+			sb.WriteByte('+')
+		case -1:
+			sb.WriteByte('-')
+		default:
+			sb.WriteByte('0')
+		}
+	}
+	return sb.String()
+}
+
+// Строковое представление числа тритами '-','0','+'
+func (z *BigWord) String() string {
+	return z.Text(3)
+}
@@ -0,0 +1,107 @@
+package ternary
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func Test_bigword_int64(t *testing.T) {
+	for _, n := range []int64{0, 1, -1, 2, -2, 13, -13, 9841, -9842, 1 << 62, -1 << 63} {
+		if got := NewBigWord(n).Int64(); got != n {
+			t.Errorf("SetInt64(%d).Int64() = %d", n, got)
+		}
+	}
+	if s := NewBigWord(8).String(); s != "+0-" {
+		t.Errorf("8 = %s, want +0-", s)
+	}
+	if s := NewBigWord(-8).Text(10); s != "-8" {
+		t.Errorf("Text(10) = %s, want -8", s)
+	}
+	for _, base := range []int{2, 16} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Text(%d): no panic", base)
+				}
+			}()
+			NewBigWord(8).Text(base)
+		}()
+		if _, ok := new(BigWord).SetString("+0-", base); ok {
+			t.Errorf("SetString(base %d) accepted", base)
+		}
+	}
+}
+
+func Test_bigword_arith(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		a := new(big.Int).Rand(rnd, new(big.Int).Lsh(big.NewInt(1), 200))
+		b := new(big.Int).Rand(rnd, new(big.Int).Lsh(big.NewInt(1), 90))
+		if rnd.Intn(2) == 0 {
+			a.Neg(a)
+		}
+		if rnd.Intn(2) == 0 {
+			b.Neg(b)
+		}
+		if b.Sign() == 0 {
+			b.SetInt64(7)
+		}
+		x := new(BigWord).SetBigInt(a)
+		y := new(BigWord).SetBigInt(b)
+
+		if got := new(BigWord).Add(x, y).BigInt(); got.Cmp(new(big.Int).Add(a, b)) != 0 {
+			t.Fatalf("%v + %v = %v", a, b, got)
+		}
+		if got := new(BigWord).Sub(x, y).BigInt(); got.Cmp(new(big.Int).Sub(a, b)) != 0 {
+			t.Fatalf("%v - %v = %v", a, b, got)
+		}
+		if got := new(BigWord).Mul(x, y).BigInt(); got.Cmp(new(big.Int).Mul(a, b)) != 0 {
+			t.Fatalf("%v * %v = %v", a, b, got)
+		}
+		q, r := new(BigWord).QuoRem(x, y, new(BigWord))
+		wq, wr := new(big.Int).QuoRem(a, b, new(big.Int))
+		if q.BigInt().Cmp(wq) != 0 || r.BigInt().Cmp(wr) != 0 {
+			t.Fatalf("%v / %v = %v, %v; want %v, %v", a, b, q.BigInt(), r.BigInt(), wq, wr)
+		}
+		if x.Cmp(y) != a.Cmp(b) {
+			t.Fatalf("Cmp(%v, %v) = %d", a, b, x.Cmp(y))
+		}
+		z, ok := new(BigWord).SetString(x.String(), 3)
+		if !ok || z.Cmp(x) != 0 {
+			t.Fatalf("SetString(%q) = %v", x.String(), z)
+		}
+	}
+}
+
+func Test_bigword_carry_limbs(t *testing.T) {
+	// перенос через границы половин (32 трита) и слов (64 трита)
+	for _, n := range []int{31, 32, 63, 64, 100, 128} {
+		ones := new(big.Int).Exp(big.NewInt(3), big.NewInt(int64(n)), nil)
+		ones.Sub(ones, big.NewInt(1)).Rsh(ones, 1) // n тритов '+'
+		x := new(BigWord).SetBigInt(ones)
+		if got := new(BigWord).Add(x, NewBigWord(1)).BigInt(); got.Cmp(new(big.Int).Add(ones, big.NewInt(1))) != 0 {
+			t.Errorf("(3^%d-1)/2 + 1 = %v", n, got)
+		}
+		if got := new(BigWord).Sub(new(BigWord).Neg(x), NewBigWord(1)).BigInt(); got.Cmp(new(big.Int).Neg(new(big.Int).Add(ones, big.NewInt(1)))) != 0 {
+			t.Errorf("-(3^%d-1)/2 - 1 = %v", n, got)
+		}
+		if got := new(BigWord).Lsh(NewBigWord(-5), uint(n)); got.Len() != n+3 || got.Trit(n) != 1 || got.Trit(n+1) != 1 || got.Trit(n+2) != -1 || got.Trit(n-1) != 0 {
+			t.Errorf("-5 * 3^%d = %s", n, got)
+		}
+	}
+	// результат на месте операнда
+	x := NewBigWord(-12345)
+	x.Add(x, x).Mul(x, x)
+	if got := x.Int64(); got != 24690*24690 {
+		t.Errorf("(2x)^2 = %d", got)
+	}
+}
+
+func Benchmark_bigword_mul(b *testing.B) {
+	x, _ := new(BigWord).SetString("+-0+-0+-0+-0+-0+-0+-0+-0+-0+-0+-0+-0+-0+-0+-0+-0+-0+-0+-0+-0", 3)
+	z := new(BigWord)
+	for i := 0; i < b.N; i++ {
+		z.Mul(x, x)
+	}
+}
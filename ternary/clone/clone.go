@@ -0,0 +1,278 @@
+/**
+ * Filename: 	clone.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+// Пакет clone проверяет функциональную полноту систем функций
+// троичной логики по критерию Яблонского: система полна тогда и только
+// тогда, когда она не содержится целиком ни в одном из 18 предполных
+// (максимальных) классов P3. Каждый класс Pol(ρ) — функции,
+// сохраняющие отношение ρ на {-,0,+}.
+package clone
+
+import (
+	"strings"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+// Отношение на множестве {-,0,+}: набор кортежей длины Arity
+type Relation struct {
+	Arity  int
+	Tuples [][]int8
+	set    map[int]bool
+}
+
+// Номер кортежа в системе счисления по основанию 3
+func tupleKey(t []int8) int {
+	k := 0
+	for _, v := range t {
+		k = 3*k + int(v+1)
+	}
+	return k
+}
+
+// Отношение из кортежей, удовлетворяющих pred
+func NewRelation(arity int, pred func(t []int8) bool) *Relation {
+	r := &Relation{Arity: arity, set: map[int]bool{}}
+	t := make([]int8, arity)
+	var gen func(i int)
+	gen = func(i int) {
+		if i == arity {
+			if pred(t) {
+				r.Tuples = append(r.Tuples, append([]int8(nil), t...))
+				r.set[tupleKey(t)] = true
+			}
+			return
+		}
+		for v := int8(-1); v <= 1; v++ {
+			t[i] = v
+			gen(i + 1)
+		}
+	}
+	gen(0)
+	return r
+}
+
+// Принадлежит ли кортеж отношению
+func (r *Relation) Has(t []int8) bool {
+	return r.set[tupleKey(t)]
+}
+
+// Сохраняет ли функция f отношение r: для любых кортежей
+// c[0], ..., c[n-1] из r кортеж f(c[0][j], ..., c[n-1][j]) тоже из r
+func Preserves(f ternary.TruthTableN, r *Relation) bool {
+	n := f.Arity()
+	if n == 0 {
+		v := f.At()
+		t := make([]int8, r.Arity)
+		for j := range t {
+			t[j] = v
+		}
+		return r.Has(t)
+	}
+	idx := make([]int, n)
+	args := make([]int8, n)
+	out := make([]int8, r.Arity)
+	for {
+		for j := 0; j < r.Arity; j++ {
+			for i := range args {
+				args[i] = r.Tuples[idx[i]][j]
+			}
+			out[j] = f.At(args...)
+		}
+		if !r.Has(out) {
+			return false
+		}
+		i := n - 1
+		for ; i >= 0; i-- {
+			idx[i]++
+			if idx[i] < len(r.Tuples) {
+				break
+			}
+			idx[i] = 0
+		}
+		if i < 0 {
+			return true
+		}
+	}
+}
+
+// Предполный класс Pol(ρ)
+type Clone struct {
+	Name     string // обозначение класса
+	Family   string // семейство по Розенбергу
+	Relation *Relation
+}
+
+// Принадлежит ли функция классу
+func (c *Clone) Contains(f ternary.TruthTableN) bool {
+	return Preserves(f, c.Relation)
+}
+
+// Семейства предполных классов
+const (
+	FamilySubset    = "subset"    // сохранение подмножества (центральные унарные)
+	FamilyCentral   = "central"   // центральные бинарные отношения
+	FamilyOrder     = "order"     // монотонность по линейному порядку
+	FamilyPartition = "partition" // сохранение разбиения
+	FamilyShift     = "shift"     // самодвойственность по циклическому сдвигу
+	FamilySlupecki  = "slupecki"  // класс Слупецкого
+	FamilyAffine    = "affine"    // линейные функции по модулю 3
+)
+
+var symb = map[int8]string{-1: "-", 0: "0", 1: "+"}
+
+// Символы тритов через разделитель
+func join(vs []int8, sep string) string {
+	s := make([]string, len(vs))
+	for i, v := range vs {
+		s[i] = symb[v]
+	}
+	return strings.Join(s, sep)
+}
+
+// Сдвиг - -> 0 -> + -> -
+func shift(a int8) int8 {
+	if a == 1 {
+		return -1
+	}
+	return a + 1
+}
+
+// 18 предполных классов троичной логики
+var Maximal = maximal()
+
+func maximal() []*Clone {
+	var cs []*Clone
+	add := func(name, family string, r *Relation) {
+		cs = append(cs, &Clone{name, family, r})
+	}
+	vals := []int8{-1, 0, 1}
+
+	// 6 непустых собственных подмножеств E
+	for _, set := range [][]int8{{-1}, {0}, {1}, {-1, 0}, {-1, 1}, {0, 1}} {
+		set := set
+		add("T{"+join(set, ",")+"}", FamilySubset, NewRelation(1, func(t []int8) bool {
+			for _, v := range set {
+				if t[0] == v {
+					return true
+				}
+			}
+			return false
+		}))
+	}
+	// 3 центральных бинарных отношения E² без пар (a,b), (b,a);
+	// центр c — третий элемент
+	for _, c := range vals {
+		c := c
+		add("C("+symb[c]+")", FamilyCentral, NewRelation(2, func(t []int8) bool {
+			return t[0] == t[1] || t[0] == c || t[1] == c
+		}))
+	}
+	// 3 линейных порядка (порядок и обратный дают один класс),
+	// различаются средним элементом
+	for _, mid := range []int8{0, -1, 1} {
+		var order []int8
+		for _, v := range vals {
+			if v != mid {
+				order = append(order, v)
+			}
+		}
+		lo, hi := order[0], order[1]
+		rank := map[int8]int{lo: 0, mid: 1, hi: 2}
+		add("M("+join([]int8{lo, mid, hi}, "<")+")", FamilyOrder, NewRelation(2, func(t []int8) bool {
+			return rank[t[0]] <= rank[t[1]]
+		}))
+	}
+	// 3 разбиения на два блока: {a,b | c}
+	for _, c := range vals {
+		c := c
+		var ab []int8
+		for _, v := range vals {
+			if v != c {
+				ab = append(ab, v)
+			}
+		}
+		add("U{"+join(ab, ",")+"|"+symb[c]+"}", FamilyPartition, NewRelation(2, func(t []int8) bool {
+			return t[0] == t[1] || t[0] != c && t[1] != c
+		}))
+	}
+	// граф циклического сдвига: f(s(x), ...) = s(f(x, ...))
+	add("S(-0+)", FamilyShift, NewRelation(2, func(t []int8) bool {
+		return t[1] == shift(t[0])
+	}))
+	// отношение Слупецкого: кортежи из не более чем двух различных значений
+	add("B", FamilySlupecki, NewRelation(3, func(t []int8) bool {
+		return t[0] == t[1] || t[0] == t[2] || t[1] == t[2]
+	}))
+	// x + y = z + u (mod 3)
+	add("L", FamilyAffine, NewRelation(4, func(t []int8) bool {
+		return (int(t[0])+int(t[1])-int(t[2])-int(t[3])+6)%3 == 0
+	}))
+	return cs
+}
+
+// Найти предполный класс по обозначению
+func Lookup(name string) (*Clone, bool) {
+	for _, c := range Maximal {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// Результат анализа системы функций
+type Report struct {
+	Complete bool     // система функционально полна
+	Clones   []*Clone // предполные классы, содержащие все функции системы
+}
+
+// Проверить полноту системы функций fs по критерию Яблонского
+func Analyze(fs ...ternary.TruthTableN) Report {
+	var rep Report
+	for _, c := range Maximal {
+		in := true
+		for _, f := range fs {
+			if !c.Contains(f) {
+				in = false
+				break
+			}
+		}
+		if in {
+			rep.Clones = append(rep.Clones, c)
+		}
+	}
+	rep.Complete = len(rep.Clones) == 0
+	return rep
+}
+
+// Функционально полна ли система функций
+func IsComplete(fs ...ternary.TruthTableN) bool {
+	return Analyze(fs...).Complete
+}
+
+// Является ли функция функцией Шеффера (полна сама по себе)
+func IsSheffer(f ternary.TruthTableN) bool {
+	return IsComplete(f)
+}
+
+// Имена классов отчета через запятую
+func (rep Report) String() string {
+	if rep.Complete {
+		return "complete"
+	}
+	names := make([]string, len(rep.Clones))
+	for i, c := range rep.Clones {
+		names[i] = c.Name
+	}
+	return "incomplete: " + strings.Join(names, ", ")
+}
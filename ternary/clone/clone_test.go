@@ -0,0 +1,65 @@
+package clone
+
+import (
+	"testing"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+func table2(f ternary.TritFunc2) ternary.TruthTableN {
+	return ternary.MakeTruthTable2(f).TableN()
+}
+
+func Test_maximal(t *testing.T) {
+	if len(Maximal) != 18 {
+		t.Fatalf("len(Maximal) = %d", len(Maximal))
+	}
+	seen := map[string]bool{}
+	for _, c := range Maximal {
+		if seen[c.Name] {
+			t.Errorf("duplicate clone %s", c.Name)
+		}
+		seen[c.Name] = true
+		// проекции принадлежат любому классу
+		if !c.Contains(ternary.TruthTableNOf(2, []int8{-1, -1, -1, 0, 0, 0, 1, 1, 1})) {
+			t.Errorf("%s does not contain projection", c.Name)
+		}
+	}
+}
+
+func Test_complete(t *testing.T) {
+	if rep := Analyze(table2(ternary.Webb)); !rep.Complete {
+		t.Errorf("{Webb}: %v", rep)
+	}
+	rep := Analyze(table2(ternary.And), ternary.MakeTruthTable1(ternary.Not))
+	if rep.Complete {
+		t.Fatalf("{And, Not}: complete")
+	}
+	// And(0,0) = Not(0) = 0
+	found := false
+	for _, c := range rep.Clones {
+		found = found || c.Name == "T{0}"
+	}
+	if !found {
+		t.Errorf("{And, Not}: %v", rep)
+	}
+	if m, ok := Lookup("M(-<0<+)"); !ok || !m.Contains(table2(ternary.And)) || m.Contains(table2(ternary.Xor)) {
+		t.Errorf("M(-<0<+): %v", m)
+	}
+	if l, ok := Lookup("L"); !ok || !l.Contains(table2(ternary.AddMod)) {
+		t.Errorf("AddMod is not affine")
+	}
+	if IsSheffer(table2(ternary.AddMod)) {
+		t.Errorf("AddMod is Sheffer")
+	}
+}
+
+func Test_sheffer_count(t *testing.T) {
+	// число функций Шеффера среди 19683 функций двух тритов
+	n := len(ternary.TruthTables2(func(f ternary.TruthTable2) bool {
+		return IsSheffer(f.TableN())
+	}))
+	if n != 3774 {
+		t.Errorf("Sheffer functions = %d, want 3774", n)
+	}
+}
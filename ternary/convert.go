@@ -0,0 +1,74 @@
+/**
+ * Filename: 	convert.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package ternary
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Ошибка: число не помещается в троичное слово заданной длины
+var ErrRange = errors.New("ternary: value out of range")
+
+// Ошибка: отрицательное число нельзя представить как uint64
+var ErrNegative = errors.New("ternary: negative value")
+
+// Значение троичного числа как int64
+// Число длиной до TritsMax тритов всегда помещается в int64.
+func (x Word) Int64() int64 {
+	var r int64
+	x = x.norm()
+	for i := int(x.l) - 1; i >= 0; i-- {
+		r = r*3 + int64(trs2int(x, uint8(i)))
+	}
+	return r
+}
+
+// Значение троичного числа как uint64
+func (x Word) Uint64() (uint64, error) {
+	n := x.Int64()
+	if n < 0 {
+		return 0, ErrNegative
+	}
+	return uint64(n), nil
+}
+
+// Значение троичного числа как *big.Int
+func (x Word) BigInt() *big.Int {
+	return big.NewInt(x.Int64())
+}
+
+// Преобразовать BigWord в троичное число длиной l тритов
+func fromBigWord(b *BigWord, l uint8, v interface{}) (Word, error) {
+	if l > TritsMax || b.Len() > int(l) {
+		return NewWord(l), fmt.Errorf("%w: %v does not fit in %d trits", ErrRange, v, l)
+	}
+	return b.wordAt(0, l), nil
+}
+
+// Преобразовать int64 в троичное число длиной l тритов
+// Например, FromInt64(n, 18) для регистра S "Сетунь-1958".
+func FromInt64(n int64, l uint8) (Word, error) {
+	return fromBigWord(NewBigWord(n), l, n)
+}
+
+// Преобразовать uint64 в троичное число длиной l тритов
+func FromUint64(n uint64, l uint8) (Word, error) {
+	return fromBigWord(new(BigWord).SetBigInt(new(big.Int).SetUint64(n)), l, n)
+}
+
+// Преобразовать *big.Int в троичное число длиной l тритов
+func FromBigInt(n *big.Int, l uint8) (Word, error) {
+	return fromBigWord(new(BigWord).SetBigInt(n), l, n)
+}
@@ -0,0 +1,54 @@
+package ternary
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func Test_int64_trs(t *testing.T) {
+	// диапазон 18-тритного регистра S: ±(3^18-1)/2
+	const max18 = 193710244
+	for _, n := range []int64{0, 1, -1, 13, -364, max18, -max18} {
+		x, err := FromInt64(n, 18)
+		if err != nil {
+			t.Fatalf("FromInt64(%d, 18): %v", n, err)
+		}
+		if x.Len() != 18 || x.Int64() != n {
+			t.Errorf("FromInt64(%d, 18).Int64() = %d", n, x.Int64())
+		}
+		if x.BigInt().Int64() != n {
+			t.Errorf("BigInt(%d) = %v", n, x.BigInt())
+		}
+	}
+	for _, n := range []int64{max18 + 1, -max18 - 1, math.MaxInt64} {
+		if _, err := FromInt64(n, 18); !errors.Is(err, ErrRange) {
+			t.Errorf("FromInt64(%d, 18): err = %v, want ErrRange", n, err)
+		}
+	}
+	if _, err := FromInt64(1, TritsMax+1); !errors.Is(err, ErrRange) {
+		t.Errorf("FromInt64(1, %d): err = %v, want ErrRange", TritsMax+1, err)
+	}
+}
+
+func Test_uint64_trs(t *testing.T) {
+	x, err := FromUint64(926510094425920, TritsMax) // (3^32-1)/2
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u, err := x.Uint64(); err != nil || u != 926510094425920 {
+		t.Errorf("Uint64() = %d, %v", u, err)
+	}
+	if _, err := FromUint64(math.MaxUint64, TritsMax); !errors.Is(err, ErrRange) {
+		t.Errorf("FromUint64(MaxUint64): err = %v, want ErrRange", err)
+	}
+	y, _ := FromInt64(-5, 9)
+	if _, err := y.Uint64(); !errors.Is(err, ErrNegative) {
+		t.Errorf("Uint64(-5): err = %v, want ErrNegative", err)
+	}
+	b := new(big.Int).Exp(big.NewInt(3), big.NewInt(40), nil)
+	if _, err := FromBigInt(b, TritsMax); !errors.Is(err, ErrRange) {
+		t.Errorf("FromBigInt(3^40): err = %v, want ErrRange", err)
+	}
+}
@@ -0,0 +1,142 @@
+/**
+ * Filename: 	encoding.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package ternary
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Сериализация тритов и троичных чисел
+//
+// Текст и JSON:  символы '-','0','+' (старший трит слева), JSON — строка.
+// Двоичный вид трита: 1 байт (0 = '-', 1 = '0', 2 = '+').
+// Двоичный вид числа: байт длины l, затем битовые поля t0 и t1
+// по (l+7)/8 байт каждое, младший трит в младшем бите первого байта.
+
+// Ошибка двоичного представления
+var ErrInvalidBinary = errors.New("ternary: invalid binary encoding")
+
+// Реализация encoding.TextMarshaler для трита
+func (t Trit) MarshalText() ([]byte, error) {
+	return []byte(t.SymbTrit()), nil
+}
+
+// Реализация encoding.TextUnmarshaler для трита
+func (t *Trit) UnmarshalText(text []byte) error {
+	v, err := ParseTrit(string(text), AlphabetTrit)
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
+
+// Реализация encoding.BinaryMarshaler для трита
+func (t Trit) MarshalBinary() ([]byte, error) {
+	return []byte{byte(t.ToInt() + 1)}, nil
+}
+
+// Реализация encoding.BinaryUnmarshaler для трита
+func (t *Trit) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 || data[0] > 2 {
+		return fmt.Errorf("%w: trit %v", ErrInvalidBinary, data)
+	}
+	*t = IntToTrit(int8(data[0]) - 1)
+	return nil
+}
+
+// Реализация json.Marshaler для трита
+func (t Trit) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.SymbTrit())
+}
+
+// Реализация json.Unmarshaler для трита
+func (t *Trit) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(s))
+}
+
+// Реализация encoding.TextMarshaler для троичного числа
+func (x Word) MarshalText() ([]byte, error) {
+	return []byte(x.Text(AlphabetTrit)), nil
+}
+
+// Реализация encoding.TextUnmarshaler для троичного числа
+// Пустой текст соответствует числу нулевой длины.
+func (x *Word) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*x = Word{}
+		return nil
+	}
+	v, err := ParseWord(string(text), AlphabetTrit)
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// Число байт одного битового поля числа длиной l
+func planeBytes(l uint8) int {
+	return (int(l) + 7) / 8
+}
+
+// Реализация encoding.BinaryMarshaler для троичного числа
+func (x Word) MarshalBinary() ([]byte, error) {
+	x = x.norm()
+	n := planeBytes(x.l)
+	data := make([]byte, 1+2*n)
+	data[0] = x.l
+	for i := 0; i < n; i++ {
+		data[1+i] = byte(x.t0 >> (8 * i))
+		data[1+n+i] = byte(x.t1 >> (8 * i))
+	}
+	return data, nil
+}
+
+// Реализация encoding.BinaryUnmarshaler для троичного числа
+func (x *Word) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] > TritsMax || len(data) != 1+2*planeBytes(data[0]) {
+		return fmt.Errorf("%w: word of %d bytes", ErrInvalidBinary, len(data))
+	}
+	v := Word{l: data[0]}
+	n := planeBytes(v.l)
+	for i := 0; i < n; i++ {
+		v.t0 |= uint32(data[1+i]) << (8 * i)
+		v.t1 |= uint32(data[1+n+i]) << (8 * i)
+	}
+	if v.norm() != v {
+		return fmt.Errorf("%w: stray bits in word", ErrInvalidBinary)
+	}
+	*x = v
+	return nil
+}
+
+// Реализация json.Marshaler для троичного числа
+func (x Word) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.Text(AlphabetTrit))
+}
+
+// Реализация json.Unmarshaler для троичного числа
+func (x *Word) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,70 @@
+package ternary
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type registerDump struct {
+	W   Trit
+	S   Word
+	Mem []Word
+}
+
+func Test_encoding_json(t *testing.T) {
+	var tr Trit
+	s, _ := FromInt64(-12345, 18)
+	m, _ := FromInt64(40, 9)
+	in := registerDump{W: tr.SetFalse(), S: s, Mem: []Word{m, NewWord(9)}}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"W":"-","S":"00000000-+0+0+--+0","Mem":["00000++++","000000000"]}`
+	if string(data) != want {
+		t.Errorf("json = %s, want %s", data, want)
+	}
+	var out registerDump
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.W != in.W || out.S != in.S || len(out.Mem) != 2 || out.Mem[0] != m || out.Mem[1] != in.Mem[1] {
+		t.Errorf("json round trip = %+v", out)
+	}
+}
+
+func Test_encoding_binary(t *testing.T) {
+	for _, n := range []int64{0, 1, -1, 926510094425920, -926510094425920} {
+		x, _ := FromInt64(n, TritsMax)
+		data, _ := x.MarshalBinary()
+		if len(data) != 9 {
+			t.Errorf("MarshalBinary(%d): %d bytes", n, len(data))
+		}
+		var y Word
+		if err := y.UnmarshalBinary(data); err != nil || y != x {
+			t.Errorf("UnmarshalBinary(%d) = %v, %v", n, y, err)
+		}
+	}
+	var y Word
+	if err := y.UnmarshalBinary([]byte{9, 0xff, 0xff, 0, 0}); !errors.Is(err, ErrInvalidBinary) {
+		t.Errorf("UnmarshalBinary(stray bits): err = %v", err)
+	}
+
+	var buf bytes.Buffer
+	s, _ := FromInt64(777, 18)
+	var tr Trit
+	in := registerDump{W: tr.SetTrue(), S: s, Mem: []Word{s}}
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+	var out registerDump
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.W != in.W || out.S != in.S || out.Mem[0] != s {
+		t.Errorf("gob round trip = %+v", out)
+	}
+}
@@ -0,0 +1,206 @@
+/**
+ * Filename: 	expr.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+// Пакет expr реализует язык формул троичной логики: разбор текста
+// в синтаксическое дерево, вычисление при заданных значениях
+// переменных и построение таблиц истинности в семантиках Клини,
+// Лукашевича, Геделя и Брусенцова.
+//
+// Грамматика (по возрастанию приоритета):
+//
+//	expr    = equiv
+//	equiv   = imp { "<->" imp }
+//	imp     = or [ "->" imp ]            (правоассоциативна)
+//	or      = xor { "|" xor }            MAX(a,b)
+//	xor     = and { "^" and }            ternary.Xor
+//	and     = unary { "&" unary }        MIN(a,b)
+//	unary   = ( "~" | "!" ) unary | primary
+//	primary = const | name | name "(" expr { "," expr } ")" | "(" expr ")"
+//	const   = "%true" | "%nil" | "%false"
+//
+// Функции name(...) — именованные функции тритов из реестра
+// ternary.LookupTruthTable2 и функции Not, Neg, Majority, Mux,
+// FullAdderSum, FullAdderCarry.
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+// Переменная не имеет значения
+var ErrUnbound = errors.New("expr: unbound variable")
+
+// Значения переменных
+type Env map[string]ternary.Trit
+
+// Узел синтаксического дерева формулы
+type Expr interface {
+	// Значение формулы при значениях переменных env в семантике sem
+	Eval(env Env, sem *Semantics) (ternary.Trit, error)
+	// Запись формулы на языке expr
+	String() string
+}
+
+// Логические связки
+type Op int
+
+const (
+	OpNot Op = iota // ~a
+	OpAnd           // a & b
+	OpOr            // a | b
+	OpXor           // a ^ b
+	OpImp           // a -> b
+	OpEqv           // a <-> b
+)
+
+var opSymb = [...]string{OpNot: "~", OpAnd: "&", OpOr: "|", OpXor: "^", OpImp: "->", OpEqv: "<->"}
+
+func (op Op) String() string {
+	if op < 0 || int(op) >= len(opSymb) {
+		return fmt.Sprintf("Op(%d)", int(op))
+	}
+	return opSymb[op]
+}
+
+// Константа %true, %nil, %false
+type Const struct {
+	Value ternary.Trit
+}
+
+// Переменная
+type Var struct {
+	Name string
+}
+
+// Отрицание
+type Unary struct {
+	Op Op
+	X  Expr
+}
+
+// Двухместная связка
+type Binary struct {
+	Op   Op
+	X, Y Expr
+}
+
+// Вызов именованной функции тритов
+type Call struct {
+	Name  string
+	Args  []Expr
+	Table ternary.TruthTableN
+}
+
+func (e *Const) Eval(env Env, sem *Semantics) (ternary.Trit, error) {
+	return e.Value, nil
+}
+
+func (e *Var) Eval(env Env, sem *Semantics) (ternary.Trit, error) {
+	v, ok := env[e.Name]
+	if !ok {
+		return v, fmt.Errorf("%w %s", ErrUnbound, e.Name)
+	}
+	return v, nil
+}
+
+func (e *Unary) Eval(env Env, sem *Semantics) (ternary.Trit, error) {
+	x, err := e.X.Eval(env, sem)
+	if err != nil {
+		return x, err
+	}
+	return sem.Not(x), nil
+}
+
+func (e *Binary) Eval(env Env, sem *Semantics) (r ternary.Trit, err error) {
+	x, err := e.X.Eval(env, sem)
+	if err != nil {
+		return r, err
+	}
+	y, err := e.Y.Eval(env, sem)
+	if err != nil {
+		return r, err
+	}
+	switch e.Op {
+	case OpAnd:
+		return sem.And(x, y), nil
+	case OpOr:
+		return sem.Or(x, y), nil
+	case OpXor:
+		return ternary.Xor(x, y), nil
+	case OpImp:
+		return sem.Imp(x, y), nil
+	case OpEqv:
+		return sem.And(sem.Imp(x, y), sem.Imp(y, x)), nil
+	}
+	panic("expr: bad binary operator " + e.Op.String())
+}
+
+func (e *Call) Eval(env Env, sem *Semantics) (r ternary.Trit, err error) {
+	args := make([]ternary.Trit, len(e.Args))
+	for i, a := range e.Args {
+		if args[i], err = a.Eval(env, sem); err != nil {
+			return r, err
+		}
+	}
+	return e.Table.Eval(args...), nil
+}
+
+func (e *Const) String() string { return e.Value.SymbLogic() }
+
+func (e *Var) String() string { return e.Name }
+
+func (e *Unary) String() string { return e.Op.String() + e.X.String() }
+
+func (e *Binary) String() string {
+	return "(" + e.X.String() + " " + e.Op.String() + " " + e.Y.String() + ")"
+}
+
+func (e *Call) String() string {
+	args := make([]string, len(e.Args))
+	for i, a := range e.Args {
+		args[i] = a.String()
+	}
+	return e.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// Имена переменных формулы в алфавитном порядке
+func Vars(e Expr) []string {
+	seen := map[string]bool{}
+	var walk func(e Expr)
+	walk = func(e Expr) {
+		switch e := e.(type) {
+		case *Var:
+			seen[e.Name] = true
+		case *Unary:
+			walk(e.X)
+		case *Binary:
+			walk(e.X)
+			walk(e.Y)
+		case *Call:
+			for _, a := range e.Args {
+				walk(a)
+			}
+		}
+	}
+	walk(e)
+	names := make([]string, 0, len(seen))
+	for n := range seen {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
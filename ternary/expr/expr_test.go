@@ -0,0 +1,97 @@
+package expr
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+func trit(v int8) ternary.Trit {
+	return ternary.IntToTrit(v)
+}
+
+func Test_parse(t *testing.T) {
+	for _, c := range []struct{ src, want string }{
+		{"a & b | c", "((a & b) | c)"},
+		{"a | b & c", "(a | (b & c))"},
+		{"a -> b -> c", "(a -> (b -> c))"},
+		{"~a ^ !b <-> %nil", "((~a ^ ~b) <-> %nil)"},
+		{"Webb(a, %true) & Majority(a, b, c)", "(Webb(a, %true) & Majority(a, b, c))"},
+		{"((x))", "x"},
+	} {
+		e, err := Parse(c.src)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", c.src, err)
+			continue
+		}
+		if s := e.String(); s != c.want {
+			t.Errorf("Parse(%q) = %s, want %s", c.src, s, c.want)
+		}
+		if e2, err := Parse(e.String()); err != nil || e2.String() != e.String() {
+			t.Errorf("Parse(%q) round trip = %v, %v", e.String(), e2, err)
+		}
+	}
+	for _, src := range []string{"", "a &", "(a", "a b", "%maybe", "Foo(a)", "Webb(a)", "a # b"} {
+		_, err := Parse(src)
+		var se *SyntaxError
+		if !errors.As(err, &se) || !errors.Is(err, ternary.ErrSyntax) {
+			t.Errorf("Parse(%q) error = %v", src, err)
+		}
+	}
+}
+
+func Test_eval(t *testing.T) {
+	e := MustParse("a & ~b | Mux(a, b, c, %true)")
+	env := Env{"a": trit(1), "b": trit(-1), "c": trit(0)}
+	if r, err := e.Eval(env, Kleene); err != nil || r.ToInt() != 1 {
+		t.Errorf("Eval = %d, %v", r.ToInt(), err)
+	}
+	if _, err := e.Eval(Env{"a": trit(1)}, Kleene); !errors.Is(err, ErrUnbound) {
+		t.Errorf("Eval unbound: %v", err)
+	}
+	if v := Vars(e); strings.Join(v, ",") != "a,b,c" {
+		t.Errorf("Vars = %v", v)
+	}
+}
+
+func Test_semantics(t *testing.T) {
+	// a -> b по строкам a = -, 0, + и столбцам b = -, 0, +
+	for _, c := range []struct {
+		sem  *Semantics
+		want string
+	}{
+		{Kleene, "+++00+-0+"},
+		{Lukasiewicz, "+++0++-0+"},
+		{Goedel, "+++-++-0+"},
+		{Brusentsov, "+00000-0+"},
+	} {
+		tt, vars, err := TruthTable(MustParse("a -> b"), c.sem)
+		if err != nil || len(vars) != 2 || tt.String() != c.want {
+			t.Errorf("%s: a -> b = %v, %v; want %s", c.sem.Name, tt, err, c.want)
+		}
+	}
+	// a -> a тождественно истинна у Лукашевича и Геделя, но не у Клини
+	for _, sem := range SemanticsAll {
+		tt, _, _ := TruthTable(MustParse("a -> a"), sem)
+		taut := tt.String() == "+++"
+		if taut != (sem == Lukasiewicz || sem == Goedel) {
+			t.Errorf("%s: a -> a = %v", sem.Name, tt)
+		}
+	}
+	if s, ok := LookupSemantics("goedel"); !ok || s != Goedel {
+		t.Errorf("LookupSemantics(goedel)")
+	}
+}
+
+func Test_write_truth_table(t *testing.T) {
+	var sb strings.Builder
+	if err := WriteTruthTable(&sb, MustParse("a & bb"), Kleene); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(sb.String(), "\n"), "\n")
+	if len(lines) != 11 || lines[0] != "a bb | (a & bb)" || lines[1] != "-----+---------" || lines[8] != "+ -  | -" {
+		t.Errorf("WriteTruthTable:\n%s", sb.String())
+	}
+}
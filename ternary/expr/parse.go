@@ -0,0 +1,262 @@
+/**
+ * Filename: 	parse.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package expr
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+// ----------------------------------------------------
+// Разбор формул
+// ----------------------------------------------------
+
+// Ошибка разбора формулы в позиции Pos (байт от начала текста)
+type SyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("expr: syntax error at %d: %s", e.Pos, e.Msg)
+}
+
+// errors.Is(err, ternary.ErrSyntax) == true
+func (e *SyntaxError) Unwrap() error {
+	return ternary.ErrSyntax
+}
+
+// Функции тритов, доступные в формулах помимо реестра TruthTable2
+var builtins = map[string]ternary.TruthTableN{
+	"Not":            ternary.MakeTruthTable1(ternary.Not),
+	"Neg":            ternary.MakeTruthTable1(ternary.Neg),
+	"Majority":       ternary.TruthTableMajority,
+	"Mux":            ternary.TruthTableMux,
+	"FullAdderSum":   ternary.TruthTableFullAdderSum,
+	"FullAdderCarry": ternary.TruthTableFullAdderCarry,
+}
+
+// Таблица истинности функции по имени
+func lookupFunc(name string) (ternary.TruthTableN, bool) {
+	if t, ok := builtins[name]; ok {
+		return t, true
+	}
+	if t, ok := ternary.LookupTruthTable2(name); ok {
+		return t.TableN(), true
+	}
+	return ternary.TruthTableN{}, false
+}
+
+// Лексемы
+type token struct {
+	pos  int
+	text string // "(", ")", ",", операция, имя или константа
+	kind byte   // 'n' имя, 'c' константа, 'o' операция, 0 конец текста
+}
+
+type parser struct {
+	src string
+	pos int
+	tok token
+	err *SyntaxError
+}
+
+// Разобрать формулу
+func Parse(src string) (Expr, error) {
+	p := &parser{src: src}
+	p.next()
+	e := p.parseExpr()
+	if p.err == nil && p.tok.kind != 0 {
+		p.fail(p.tok.pos, "unexpected %q", p.tok.text)
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return e, nil
+}
+
+// Разобрать формулу (паника при ошибке)
+func MustParse(src string) Expr {
+	e, err := Parse(src)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+func (p *parser) fail(pos int, format string, args ...interface{}) {
+	if p.err == nil {
+		p.err = &SyntaxError{pos, fmt.Sprintf(format, args...)}
+	}
+}
+
+func isLetter(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// Прочитать следующую лексему
+func (p *parser) next() {
+	s := p.src
+	for p.pos < len(s) && strings.IndexByte(" \t\r\n", s[p.pos]) >= 0 {
+		p.pos++
+	}
+	start := p.pos
+	if p.pos >= len(s) {
+		p.tok = token{pos: start}
+		return
+	}
+	c := s[p.pos]
+	switch {
+	case isLetter(c) || c == '%':
+		p.pos++
+		for p.pos < len(s) && (isLetter(s[p.pos]) || isDigit(s[p.pos])) {
+			p.pos++
+		}
+		kind := byte('n')
+		if c == '%' {
+			kind = 'c'
+		}
+		p.tok = token{start, s[start:p.pos], kind}
+		return
+	case strings.HasPrefix(s[p.pos:], "<->"):
+		p.pos += 3
+	case strings.HasPrefix(s[p.pos:], "->"):
+		p.pos += 2
+	case strings.IndexByte("()~!&|^,", c) >= 0:
+		p.pos++
+	default:
+		p.fail(start, "unexpected character %q", c)
+		p.pos = len(s)
+		p.tok = token{pos: start}
+		return
+	}
+	p.tok = token{start, s[start:p.pos], 'o'}
+}
+
+func (p *parser) is(op string) bool {
+	return p.tok.kind == 'o' && p.tok.text == op
+}
+
+func (p *parser) expect(op string) {
+	if !p.is(op) {
+		p.fail(p.tok.pos, "expected %q", op)
+		return
+	}
+	p.next()
+}
+
+func (p *parser) parseExpr() Expr {
+	x := p.parseImp()
+	for p.is("<->") {
+		p.next()
+		x = &Binary{OpEqv, x, p.parseImp()}
+	}
+	return x
+}
+
+func (p *parser) parseImp() Expr {
+	x := p.parseBinary(0)
+	if p.is("->") {
+		p.next()
+		return &Binary{OpImp, x, p.parseImp()}
+	}
+	return x
+}
+
+// Левоассоциативные связки по возрастанию приоритета
+var binaryLevels = []struct {
+	symb string
+	op   Op
+}{
+	{"|", OpOr},
+	{"^", OpXor},
+	{"&", OpAnd},
+}
+
+func (p *parser) parseBinary(level int) Expr {
+	if level == len(binaryLevels) {
+		return p.parseUnary()
+	}
+	x := p.parseBinary(level + 1)
+	for p.is(binaryLevels[level].symb) {
+		p.next()
+		x = &Binary{binaryLevels[level].op, x, p.parseBinary(level + 1)}
+	}
+	return x
+}
+
+func (p *parser) parseUnary() Expr {
+	if p.is("~") || p.is("!") {
+		p.next()
+		return &Unary{OpNot, p.parseUnary()}
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() Expr {
+	tok := p.tok
+	switch {
+	case tok.kind == 'c':
+		p.next()
+		var t ternary.Trit
+		switch tok.text {
+		case "%true":
+			return &Const{t.SetTrue()}
+		case "%nil":
+			return &Const{t.SetNil()}
+		case "%false":
+			return &Const{t.SetFalse()}
+		}
+		p.fail(tok.pos, "unknown constant %q", tok.text)
+		return &Const{}
+	case tok.kind == 'n':
+		p.next()
+		if !p.is("(") {
+			return &Var{tok.text}
+		}
+		p.next()
+		var args []Expr
+		for !p.is(")") && p.err == nil {
+			args = append(args, p.parseExpr())
+			if !p.is(",") {
+				break
+			}
+			p.next()
+		}
+		p.expect(")")
+		t, ok := lookupFunc(tok.text)
+		if !ok {
+			p.fail(tok.pos, "unknown function %s", tok.text)
+		} else if t.Arity() != len(args) {
+			p.fail(tok.pos, "function %s takes %d arguments, got %d", tok.text, t.Arity(), len(args))
+		}
+		return &Call{tok.text, args, t}
+	case p.is("("):
+		p.next()
+		x := p.parseExpr()
+		p.expect(")")
+		return x
+	case tok.kind == 0:
+		p.fail(tok.pos, "unexpected end of formula")
+	default:
+		p.fail(tok.pos, "unexpected %q", tok.text)
+	}
+	p.next()
+	return &Const{}
+}
@@ -0,0 +1,72 @@
+/**
+ * Filename: 	semantics.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package expr
+
+import "github.com/askfind/goTernaryArithmetic/ternary"
+
+// ----------------------------------------------------
+// Семантики троичной логики
+// ----------------------------------------------------
+
+// Семантика логических связок: отрицание, конъюнкция, дизъюнкция
+// и импликация. Эквивалентность a <-> b вычисляется как
+// (a -> b) & (b -> a).
+type Semantics struct {
+	Name string
+	Not  ternary.TritFunc1
+	And  ternary.TritFunc2
+	Or   ternary.TritFunc2
+	Imp  ternary.TritFunc2 // Imp(a, b) = a -> b
+}
+
+// Функции импликаций (материальной для Клини), Лукашевича, Геделя
+// и Брусенцова в пакете ternary принимают следствие первым аргументом
+// (строки таблицы), поэтому здесь аргументы переставлены.
+var (
+	// Логика Клини: a -> b = MAX(-a, b)
+	Kleene = &Semantics{"kleene", ternary.Not, ternary.And, ternary.Or,
+		func(a, b ternary.Trit) ternary.Trit { return ternary.MaterialImplication(b, a) }}
+
+	// Логика Лукашевича: a -> b = MIN(+, + - a + b)
+	Lukasiewicz = &Semantics{"lukasiewicz", ternary.Not, ternary.And, ternary.Or,
+		func(a, b ternary.Trit) ternary.Trit { return ternary.LukashevichImplication(b, a) }}
+
+	// Логика Геделя: a -> b = +, если a <= b, иначе b; ~a = a -> -
+	Goedel = &Semantics{"goedel", goedelNot, ternary.And, ternary.Or,
+		func(a, b ternary.Trit) ternary.Trit { return ternary.GoedelImplication(b, a) }}
+
+	// Логика Брусенцова: импликация — функция следования
+	Brusentsov = &Semantics{"brusentsov", ternary.Not, ternary.And, ternary.Or,
+		func(a, b ternary.Trit) ternary.Trit { return ternary.FollowingBrusentsov(b, a) }}
+)
+
+// Все семантики
+var SemanticsAll = []*Semantics{Kleene, Lukasiewicz, Goedel, Brusentsov}
+
+// Отрицание Геделя: + для -, иначе -
+func goedelNot(a ternary.Trit) (r ternary.Trit) {
+	if a.IsFalse() {
+		return r.SetTrue()
+	}
+	return r.SetFalse()
+}
+
+// Найти семантику по имени
+func LookupSemantics(name string) (*Semantics, bool) {
+	for _, s := range SemanticsAll {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return nil, false
+}
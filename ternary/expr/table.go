@@ -0,0 +1,84 @@
+/**
+ * Filename: 	table.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package expr
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+)
+
+// ----------------------------------------------------
+// Таблицы истинности формул
+// ----------------------------------------------------
+
+// Таблица истинности формулы по ее переменным Vars(e)
+// Переменные — аргументы функции в том же порядке.
+func TruthTable(e Expr, sem *Semantics) (ternary.TruthTableN, []string, error) {
+	vars := Vars(e)
+	var err error
+	t := ternary.MakeTruthTableN(len(vars), func(args ...ternary.Trit) ternary.Trit {
+		env := make(Env, len(vars))
+		for i, v := range vars {
+			env[v] = args[i]
+		}
+		r, e2 := e.Eval(env, sem)
+		if e2 != nil && err == nil {
+			err = e2
+		}
+		return r
+	})
+	if err != nil {
+		return ternary.TruthTableN{}, nil, err
+	}
+	return t, vars, nil
+}
+
+// Вывести таблицу истинности формулы построчно:
+//
+//	a b | (a -> b)
+//	----+---------
+//	- - | +
+//	- 0 | +
+//	...
+func WriteTruthTable(w io.Writer, e Expr, sem *Semantics) error {
+	t, vars, err := TruthTable(e, sem)
+	if err != nil {
+		return err
+	}
+	head := strings.Join(vars, " ")
+	if head != "" {
+		head += " "
+	}
+	title := e.String()
+	if _, err := fmt.Fprintf(w, "%s| %s\n%s+%s\n", head, title,
+		strings.Repeat("-", len(head)), strings.Repeat("-", len(title)+1)); err != nil {
+		return err
+	}
+	for k, v := range t.Table() {
+		var row strings.Builder
+		for i, v := range vars {
+			m := k
+			for j := len(vars) - 1; j > i; j-- {
+				m /= 3
+			}
+			fmt.Fprintf(&row, "%-*s ", len(v), ternary.IntToTrit(int8(m%3)-1).SymbTrit())
+		}
+		if _, err := fmt.Fprintf(w, "%s| %s\n", row.String(), ternary.IntToTrit(v).SymbTrit()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
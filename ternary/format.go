@@ -0,0 +1,215 @@
+/**
+ * Filename: 	format.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package ternary
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Форматирование тритов и троичных чисел для пакета fmt
+//
+//	%v, %s  символы '-','0','+'     (Trit: "+", Word: "+0-")
+//	%d      десятичное значение     (Trit: "1", Word: "8")
+//	%t      логические 'f','n','t'  (Trit: "t", Word: "tnf")
+//
+// Ширина и флаг '-' выравнивают результат пробелами. Флаг '0' для %v, %s
+// и %t дополняет число слева символами нулевого трита до ширины,
+// для %d — нулями, как для целых чисел.
+// Троичное число выводится на всю длину l, включая старшие нулевые триты.
+
+// Строка трита
+func (t Trit) String() string {
+	return t.SymbTrit()
+}
+
+// Строка троичного числа тритами '-','0','+'
+func (x Word) String() string {
+	return x.Text(AlphabetTrit)
+}
+
+// Восстановить строку формата из fmt.State
+func fmtVerb(f fmt.State, verb rune) string {
+	var sb strings.Builder
+	sb.WriteByte('%')
+	for _, c := range "+-# 0" {
+		if f.Flag(int(c)) {
+			sb.WriteRune(c)
+		}
+	}
+	if w, ok := f.Width(); ok {
+		sb.WriteString(strconv.Itoa(w))
+	}
+	if p, ok := f.Precision(); ok {
+		sb.WriteByte('.')
+		sb.WriteString(strconv.Itoa(p))
+	}
+	sb.WriteRune(verb)
+	return sb.String()
+}
+
+// Вывести строку s с учетом ширины и флагов
+// zero — символ дополнения при флаге '0'
+func fmtPad(f fmt.State, s string, zero string) {
+	w, ok := f.Width()
+	n := utf8.RuneCountInString(s)
+	if !ok || n >= w {
+		fmt.Fprint(f, s)
+		return
+	}
+	switch {
+	case f.Flag('-'):
+		fmt.Fprint(f, s+strings.Repeat(" ", w-n))
+	case f.Flag('0') && zero != "":
+		fmt.Fprint(f, strings.Repeat(zero, w-n)+s)
+	default:
+		fmt.Fprint(f, strings.Repeat(" ", w-n)+s)
+	}
+}
+
+// Реализация fmt.Formatter для трита
+func (t Trit) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's':
+		fmtPad(f, t.SymbTrit(), "")
+	case 'd':
+		fmt.Fprintf(f, fmtVerb(f, verb), t.ToInt())
+	case 't':
+		fmtPad(f, t.Symb(AlphabetFNT), "")
+	default:
+		fmt.Fprintf(f, "%%!%c(ternary.Trit=%s)", verb, t.SymbTrit())
+	}
+}
+
+// Реализация fmt.Formatter для троичного числа
+func (x Word) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's':
+		fmtPad(f, x.Text(AlphabetTrit), AlphabetTrit.symb(0))
+	case 'd':
+		fmt.Fprintf(f, fmtVerb(f, verb), x.Int64())
+	case 't':
+		fmtPad(f, x.Text(AlphabetFNT), AlphabetFNT.symb(0))
+	default:
+		fmt.Fprintf(f, "%%!%c(ternary.Word=%s)", verb, x.String())
+	}
+}
+
+// Алфавит для глагола сканирования
+func scanAlphabet(verb rune) (Alphabet, bool) {
+	switch verb {
+	case 'v', 's':
+		return AlphabetTrit, true
+	case 't':
+		return AlphabetFNT, true
+	}
+	return Alphabet{}, false
+}
+
+// Символ принадлежит алфавиту без разделителей
+func inAlphabet(a Alphabet, r rune) bool {
+	for _, s := range a.Symb {
+		if s == string(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// Символ десятичного числа
+func isDecimal(r rune) bool {
+	return r == '-' || r == '+' || ('0' <= r && r <= '9')
+}
+
+// Реализация fmt.Scanner для трита
+// %v, %s — '-','0','+'; %d — -1, 0, 1; %t — 'f','n','t'.
+func (t *Trit) Scan(state fmt.ScanState, verb rune) error {
+	state.SkipSpace()
+	switch verb {
+	case 'v', 's':
+		r, _, err := state.ReadRune()
+		if err != nil {
+			return err
+		}
+		v, err := ParseTrit(string(r), AlphabetTrit)
+		*t = v
+		return err
+	case 'd':
+		tok, err := state.Token(false, isDecimal)
+		if err != nil {
+			return err
+		}
+		v, err := ParseTrit(string(tok), AlphabetNumb)
+		*t = v
+		return err
+	case 't':
+		r, _, err := state.ReadRune()
+		if err != nil {
+			return err
+		}
+		v, err := ParseTrit(string(r), AlphabetFNT)
+		*t = v
+		return err
+	}
+	return fmt.Errorf("ternary: bad verb '%%%c' for Trit", verb)
+}
+
+// Реализация fmt.Scanner для троичного числа
+// %v, %s — триты '-','0','+'; %t — триты 'f','n','t'.
+// Длина результата равна числу прочитанных тритов.
+// %d — десятичное число; длина сохраняется, если x.Len() > 0,
+// иначе выбирается наименьшая достаточная длина.
+func (x *Word) Scan(state fmt.ScanState, verb rune) error {
+	state.SkipSpace()
+	if a, ok := scanAlphabet(verb); ok {
+		tok, err := state.Token(false, func(r rune) bool { return inAlphabet(a, r) })
+		if err != nil {
+			return err
+		}
+		if len(tok) == 0 {
+			return fmt.Errorf("%w: no trits", ErrSyntax)
+		}
+		v, err := ParseWord(string(tok), a)
+		if err != nil {
+			return err
+		}
+		*x = v
+		return nil
+	}
+	if verb != 'd' {
+		return fmt.Errorf("ternary: bad verb '%%%c' for Word", verb)
+	}
+	tok, err := state.Token(false, isDecimal)
+	if err != nil {
+		return err
+	}
+	n, err := strconv.ParseInt(string(tok), 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrSyntax, err)
+	}
+	l := x.l
+	if l == 0 {
+		l = uint8(NewBigWord(n).Len())
+		if l == 0 {
+			l = 1
+		}
+	}
+	v, err := FromInt64(n, l)
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
@@ -0,0 +1,74 @@
+package ternary
+
+import (
+	"fmt"
+	"testing"
+)
+
+func Test_format(t *testing.T) {
+	var tr Trit
+	x, _ := FromInt64(8, 5)
+	for _, c := range []struct {
+		format string
+		arg    interface{}
+		want   string
+	}{
+		{"%v", tr.SetTrue(), "+"},
+		{"%d", tr.SetFalse(), "-1"},
+		{"%t", tr.SetNil(), "n"},
+		{"%t", tr.SetTrue(), "t"},
+		{"%3v|", tr.SetTrue(), "  +|"},
+		{"%v", x, "00+0-"},
+		{"%s", x, "00+0-"},
+		{"%d", x, "8"},
+		{"%+05d", x, "+0008"},
+		{"%t", x, "nntnf"},
+		{"%t", NewWord(1).SetTrue(0), "t"},
+		{"%8v", x, "   00+0-"},
+		{"%-8v|", x, "00+0-   |"},
+		{"%08v", x, "00000+0-"},
+		{"%x", x, "%!x(ternary.Word=00+0-)"},
+	} {
+		if got := fmt.Sprintf(c.format, c.arg); got != c.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", c.format, got, c.want)
+		}
+	}
+	sf, sfc := AddFullSlowly(tr.SetFalse(), tr.SetFalse(), tr.SetNil())
+	if got := fmt.Sprint(sf, sfc); got != "+ -" {
+		t.Errorf("Sprint(add_full) = %q", got)
+	}
+}
+
+func Test_scan(t *testing.T) {
+	var a, b Trit
+	var x, y Word
+	if _, err := fmt.Sscan("+ +0--0", &a, &x); err != nil {
+		t.Fatal(err)
+	}
+	if !a.IsTrue() || x.Len() != 5 || x.Int64() != 69 {
+		t.Errorf("Sscan = %v %v", a, x)
+	}
+	if _, err := fmt.Sscanf("-1 tnf", "%d %t", &b, &y); err != nil {
+		t.Fatal(err)
+	}
+	if !b.IsFalse() || y.Int64() != 8 {
+		t.Errorf("Sscanf = %v %v", b, y)
+	}
+	// %t трита и числа — одна запись 'f','n','t'
+	if _, err := fmt.Sscanf("f tf", "%t %t", &b, &y); err != nil {
+		t.Fatal(err)
+	}
+	if !b.IsFalse() || y.Int64() != 2 {
+		t.Errorf("Sscanf(%%t) = %v %v", b, y)
+	}
+	z := NewWord(9)
+	if _, err := fmt.Sscanf("-100", "%d", &z); err != nil {
+		t.Fatal(err)
+	}
+	if z.Len() != 9 || z.Int64() != -100 {
+		t.Errorf("Sscanf(%%d) = %v (%d)", z, z.Int64())
+	}
+	if _, err := fmt.Sscan("x+", &x); err == nil {
+		t.Errorf("Sscan(x+): no error")
+	}
+}
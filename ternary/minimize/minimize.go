@@ -0,0 +1,398 @@
+/**
+ * Filename: 	minimize.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+// Пакет minimize строит минимизированную нормальную форму MAX-MIN
+// функции n тритов по ее таблице истинности:
+//
+//	f = MAX( MIN(c1, x{S11}, y{S12}, ...), MIN(c2, ...), ... )
+//
+// Литерал-окно x{S} равен +, если x принадлежит множеству S, иначе -.
+// Константа терма c равна + или 0; функция равна -, если не равен +
+// ни один терм. Термы уровня + покрывают точки f = +, термы уровня 0 —
+// точки f = 0 (точки f = + для них безразличны). Простые импликанты
+// строятся склеиванием кубов по Квайну-Мак-Класки, покрытие выбирается
+// из существенных импликант, далее жадно. Метод Form.Expr записывает
+// форму формулой пакета expr.
+package minimize
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+	"github.com/askfind/goTernaryArithmetic/ternary/expr"
+)
+
+// Наибольшее число переменных минимизируемой функции
+const MaxVars = 8
+
+// Слишком много переменных
+var ErrTooManyVars = errors.New("minimize: too many variables")
+
+// Множество значений литерала-окна: бит 0 — '-', бит 1 — '0', бит 2 — '+'
+type Set uint8
+
+// Все значения трита
+const Full Set = 7
+
+// Принадлежит ли трит v множеству
+func (s Set) Has(v int8) bool {
+	return s&(1<<uint(v+1)) != 0
+}
+
+// Запись множества символами тритов: "-0", "+"
+func (s Set) String() string {
+	var sb strings.Builder
+	for v := int8(-1); v <= 1; v++ {
+		if s.Has(v) {
+			sb.WriteString(ternary.IntToTrit(v).SymbTrit())
+		}
+	}
+	return sb.String()
+}
+
+// Литерал-окно x{S}
+type Literal struct {
+	Var int // номер переменной
+	Set Set
+}
+
+// Терм MIN(Value, literals...), Value = + или 0
+type Term struct {
+	Value int8
+	Lits  []Literal
+}
+
+// Нормальная форма MAX-MIN
+type Form struct {
+	Vars  []string // имена переменных
+	Terms []Term
+}
+
+// Значение формы при значениях переменных args
+func (f *Form) Eval(args ...int8) int8 {
+	r := int8(-1)
+	for _, t := range f.Terms {
+		v := t.Value
+		for _, l := range t.Lits {
+			if !l.Set.Has(args[l.Var]) {
+				v = -1
+				break
+			}
+		}
+		if v > r {
+			r = v
+		}
+	}
+	return r
+}
+
+// Таблица истинности формы
+func (f *Form) TruthTable() ternary.TruthTableN {
+	return ternary.MakeTruthTableN(len(f.Vars), func(args ...ternary.Trit) ternary.Trit {
+		v := make([]int8, len(args))
+		for i, a := range args {
+			v[i] = a.ToInt()
+		}
+		return ternary.IntToTrit(f.Eval(v...))
+	})
+}
+
+// Число литералов формы (оценка сложности схемы)
+func (f *Form) Literals() int {
+	n := 0
+	for _, t := range f.Terms {
+		n += len(t.Lits)
+	}
+	return n
+}
+
+// Запись формы: термы через " | ", литералы через " & ",
+// константа 0 — первый множитель термов уровня 0:
+//
+//	x{+} & y{+} | 0 & x{0+} & y{0+}
+//
+// Форма без термов записывается "-", терм без литералов — "+" или "0".
+// Запись на языке expr возвращает метод Expr.
+func (f *Form) String() string {
+	if len(f.Terms) == 0 {
+		return "-"
+	}
+	terms := make([]string, len(f.Terms))
+	for i, t := range f.Terms {
+		var parts []string
+		if t.Value == 0 || len(t.Lits) == 0 {
+			parts = append(parts, ternary.IntToTrit(t.Value).SymbTrit())
+		}
+		for _, l := range t.Lits {
+			parts = append(parts, fmt.Sprintf("%s{%s}", f.Vars[l.Var], l.Set))
+		}
+		terms[i] = strings.Join(parts, " & ")
+	}
+	return strings.Join(terms, " | ")
+}
+
+// Формула языка expr, равная форме: литерал-окно x{S} записывается
+// мультиплексором Mux(x, c-, c0, c+), где cv = %true, если v из S,
+// иначе %false; термы и литералы соединяются связками "|" и "&"
+// (MAX и MIN во всех семантиках expr):
+//
+//	Mux(a, %false, %false, %true) & Mux(b, %false, %false, %true) | ...
+func (f *Form) Expr() expr.Expr {
+	if len(f.Terms) == 0 {
+		return constExpr(-1)
+	}
+	var r expr.Expr
+	for _, t := range f.Terms {
+		var term expr.Expr
+		if t.Value == 0 || len(t.Lits) == 0 {
+			term = constExpr(t.Value)
+		}
+		for _, l := range t.Lits {
+			args := []expr.Expr{&expr.Var{Name: f.Vars[l.Var]}}
+			for v := int8(-1); v <= 1; v++ {
+				c := int8(-1)
+				if l.Set.Has(v) {
+					c = 1
+				}
+				args = append(args, constExpr(c))
+			}
+			lit := &expr.Call{Name: "Mux", Args: args, Table: ternary.TruthTableMux}
+			term = join(expr.OpAnd, term, lit)
+		}
+		r = join(expr.OpOr, r, term)
+	}
+	return r
+}
+
+// Константа формулы: %false, %nil или %true
+func constExpr(v int8) expr.Expr {
+	return &expr.Const{Value: ternary.IntToTrit(v)}
+}
+
+// Соединить x и y связкой op; x == nil — первый операнд
+func join(op expr.Op, x expr.Expr, y expr.Expr) expr.Expr {
+	if x == nil {
+		return y
+	}
+	return &expr.Binary{Op: op, X: x, Y: y}
+}
+
+// Куб: множество значений каждой переменной
+type cube []Set
+
+// Точки куба (номера строк таблицы истинности)
+func (c cube) points() []int {
+	ps := []int{0}
+	for _, s := range c {
+		var next []int
+		for _, p := range ps {
+			for v := int8(-1); v <= 1; v++ {
+				if s.Has(v) {
+					next = append(next, 3*p+int(v+1))
+				}
+			}
+		}
+		ps = next
+	}
+	return ps
+}
+
+// Ключ куба: множества переменных в восьмеричных разрядах
+func (c cube) key() uint32 {
+	var k uint32
+	for _, s := range c {
+		k = k<<3 | uint32(s)
+	}
+	return k
+}
+
+// Простые импликанты: наибольшие кубы, все точки которых в allowed
+func primes(n int, allowed []bool) []cube {
+	valid := map[uint32]cube{}
+	var queue []cube
+	for k, ok := range allowed {
+		if !ok {
+			continue
+		}
+		c := make(cube, n)
+		for i, m := n-1, k; i >= 0; i, m = i-1, m/3 {
+			c[i] = 1 << uint(m%3)
+		}
+		valid[c.key()] = c
+		queue = append(queue, c)
+	}
+	// склеивание кубов, различающихся одной переменной
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		for i := range c {
+			for add := Set(1); add < Full; add <<= 1 {
+				if c[i]&add != 0 {
+					continue
+				}
+				// соседний куб с множеством add в переменной i
+				d := append(cube(nil), c...)
+				d[i] = add
+				if _, ok := valid[d.key()]; !ok {
+					continue
+				}
+				d[i] = c[i] | add
+				if _, ok := valid[d.key()]; !ok {
+					valid[d.key()] = d
+					queue = append(queue, d)
+				}
+			}
+		}
+	}
+	// куб прост, если его нельзя расширить ни по одной переменной
+	var ps []cube
+	for _, c := range valid {
+		prime := true
+		for i := range c {
+			for add := Set(1); add < Full && prime; add <<= 1 {
+				if c[i]&add == 0 {
+					d := append(cube(nil), c...)
+					d[i] |= add
+					_, ok := valid[d.key()]
+					prime = !ok
+				}
+			}
+		}
+		if prime {
+			ps = append(ps, c)
+		}
+	}
+	sort.Slice(ps, func(i, j int) bool { return ps[i].key() < ps[j].key() })
+	return ps
+}
+
+// Число литералов куба
+func (c cube) literals() int {
+	n := 0
+	for _, s := range c {
+		if s != Full {
+			n++
+		}
+	}
+	return n
+}
+
+// Покрыть точки on простыми импликантами: сначала существенные,
+// затем жадно по числу новых точек и числу литералов
+func cover(ps []cube, on []bool) []cube {
+	pts := make([][]int, len(ps))
+	for i, c := range ps {
+		pts[i] = c.points()
+	}
+	covered := make([]bool, len(on))
+	var r []cube
+	take := func(i int) {
+		r = append(r, ps[i])
+		for _, p := range pts[i] {
+			covered[p] = true
+		}
+	}
+	// существенные импликанты: единственные покрывающие точку
+	for k, ok := range on {
+		if !ok || covered[k] {
+			continue
+		}
+		only := -1
+		for i := range ps {
+			for _, p := range pts[i] {
+				if p == k {
+					if only == -1 {
+						only = i
+					} else {
+						only = -2
+					}
+					break
+				}
+			}
+		}
+		if only >= 0 {
+			take(only)
+		}
+	}
+	for {
+		best, bestGain := -1, 0
+		for i := range ps {
+			gain := 0
+			for _, p := range pts[i] {
+				if on[p] && !covered[p] {
+					gain++
+				}
+			}
+			if gain > bestGain || gain == bestGain && gain > 0 && ps[i].literals() < ps[best].literals() {
+				best, bestGain = i, gain
+			}
+		}
+		if best < 0 {
+			return r
+		}
+		take(best)
+	}
+}
+
+// Минимизировать функцию, заданную таблицей истинности
+// Имена переменных vars необязательны (по умолчанию x0, x1, ...).
+func Minimize(t ternary.TruthTableN, vars ...string) (*Form, error) {
+	n := t.Arity()
+	if n > MaxVars {
+		return nil, fmt.Errorf("%w: %d > %d", ErrTooManyVars, n, MaxVars)
+	}
+	if len(vars) != n {
+		vars = make([]string, n)
+		for i := range vars {
+			vars[i] = fmt.Sprintf("x%d", i)
+		}
+	}
+	tbl := t.Table()
+	f := &Form{Vars: vars}
+	for _, level := range []int8{1, 0} {
+		on := make([]bool, len(tbl))
+		allowed := make([]bool, len(tbl))
+		some := false
+		for k, v := range tbl {
+			on[k] = v == level
+			allowed[k] = v >= level
+			some = some || on[k]
+		}
+		if !some {
+			continue
+		}
+		cs := cover(primes(n, allowed), on)
+		sort.Slice(cs, func(i, j int) bool { return cs[i].key() < cs[j].key() })
+		for _, c := range cs {
+			term := Term{Value: level}
+			for i, s := range c {
+				if s != Full {
+					term.Lits = append(term.Lits, Literal{i, s})
+				}
+			}
+			f.Terms = append(f.Terms, term)
+		}
+	}
+	return f, nil
+}
+
+// Минимизировать формулу в заданной семантике
+func MinimizeExpr(e expr.Expr, sem *expr.Semantics) (*Form, error) {
+	t, vars, err := expr.TruthTable(e, sem)
+	if err != nil {
+		return nil, err
+	}
+	return Minimize(t, vars...)
+}
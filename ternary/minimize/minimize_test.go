@@ -0,0 +1,107 @@
+package minimize
+
+import (
+	"testing"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+	"github.com/askfind/goTernaryArithmetic/ternary/expr"
+)
+
+func Test_minimize_all2(t *testing.T) {
+	for _, tt := range ternary.TruthTables2(nil) {
+		f, err := Minimize(tt.TableN(), "a", "b")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !f.TruthTable().Equal(tt.TableN()) {
+			t.Fatalf("Minimize(%v) = %s", tt, f)
+		}
+	}
+}
+
+func Test_minimize(t *testing.T) {
+	for _, c := range []struct {
+		f    ternary.TruthTableN
+		want string
+	}{
+		{ternary.MakeTruthTable2(ternary.And).TableN(), "a{+} & b{+} | 0 & a{0+} & b{0+}"},
+		{ternary.MakeTruthTable2(ternary.Or).TableN(), "a{+} | b{+} | 0 & a{0+} | 0 & b{0+}"},
+		{ternary.TruthTableNOf(1, []int8{1, 1, 1}), "+"},
+		{ternary.TruthTableNOf(1, []int8{-1, -1, -1}), "-"},
+		{ternary.MakeTruthTable1(ternary.Not), "a{-} | 0 & a{-0}"},
+	} {
+		f, _ := Minimize(c.f, []string{"a", "b"}[:c.f.Arity()]...)
+		if f.String() != c.want {
+			t.Errorf("Minimize(%v) = %s, want %s", c.f, f, c.want)
+		}
+	}
+	for _, f := range []ternary.TruthTableN{ternary.TruthTableMajority, ternary.TruthTableFullAdderSum, ternary.TruthTableMux} {
+		m, err := Minimize(f)
+		if err != nil || !m.TruthTable().Equal(f) {
+			t.Errorf("Minimize(%v) = %s, %v", f, m, err)
+		}
+	}
+	if _, err := Minimize(ternary.MakeTruthTableN(MaxVars+1, func(a ...ternary.Trit) ternary.Trit { return a[0] })); err == nil {
+		t.Errorf("Minimize(%d vars): no error", MaxVars+1)
+	}
+}
+
+func Test_minimize_expr(t *testing.T) {
+	// поглощение: a | a & b = a
+	f, err := MinimizeExpr(expr.MustParse("a | a & b"), expr.Kleene)
+	if err != nil || f.String() != "a{+} | 0 & a{0+}" {
+		t.Errorf("MinimizeExpr = %v, %v", f, err)
+	}
+}
+
+// Таблица истинности формулы e по переменным vars; переменные,
+// от которых функция не зависит, выпадают из минимизированной формы
+func tableOf(t *testing.T, e expr.Expr, sem *expr.Semantics, vars []string) ternary.TruthTableN {
+	t.Helper()
+	tt, evars, err := expr.TruthTable(e, sem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evars) == len(vars) {
+		return tt
+	}
+	return ternary.MakeTruthTableN(len(vars), func(args ...ternary.Trit) ternary.Trit {
+		env := expr.Env{}
+		for i, v := range vars {
+			env[v] = args[i]
+		}
+		r, _ := e.Eval(env, sem)
+		return r
+	})
+}
+
+func Test_form_expr(t *testing.T) {
+	vars := []string{"a", "b"}
+	for _, tt := range ternary.TruthTables2(nil) {
+		f, _ := Minimize(tt.TableN(), vars...)
+		// запись формы разбирается пакетом expr
+		e, err := expr.Parse(f.Expr().String())
+		if err != nil {
+			t.Fatalf("%v: Parse(%s): %v", tt, f.Expr(), err)
+		}
+		for _, sem := range expr.SemanticsAll {
+			if got := tableOf(t, e, sem, vars); !got.Equal(tt.TableN()) {
+				t.Fatalf("%v (%s): %s = %v", tt, sem.Name, e, got)
+			}
+		}
+	}
+	for _, name := range ternary.TruthTable2Names() {
+		src := expr.MustParse(name + "(a, b) | Majority(a, b, c)")
+		f, err := MinimizeExpr(src, expr.Kleene)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, _, _ := expr.TruthTable(src, expr.Kleene)
+		if got := tableOf(t, f.Expr(), expr.Kleene, f.Vars); !got.Equal(want) {
+			t.Errorf("%s: %s = %v, want %v", src, f.Expr(), got, want)
+		}
+	}
+	if got := (&Form{}).Expr().String(); got != "%false" {
+		t.Errorf("empty form = %s", got)
+	}
+}
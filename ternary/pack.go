@@ -0,0 +1,179 @@
+/**
+ * Filename: 	pack.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package ternary
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// Плотная упаковка тритов: 5 тритов в байте (3^5 = 243 <= 256)
+// Байт = сумма (trit[i]+1) * 3^i, i = 0..4; первый трит потока —
+// младший. Значения 243..255 недопустимы. Неполная последняя группа
+// дополняется тритами 0 (Nil), поэтому число тритов в потоке хранится
+// отдельно (например, как длина образа памяти).
+const TritsPerByte = 5
+
+// Степени тройки для одной группы
+var pow3Byte = [TritsPerByte]byte{1, 3, 9, 27, 81}
+
+// Упаковать группу до пяти тритов в байт
+func packGroup(g []int8) byte {
+	var b byte
+	for i := 0; i < TritsPerByte; i++ {
+		var t int8
+		if i < len(g) {
+			t = g[i]
+		}
+		b += byte(t+1) * pow3Byte[i]
+	}
+	return b
+}
+
+// Распаковать байт в группу из пяти тритов
+func unpackGroup(b byte, g *[TritsPerByte]int8) error {
+	if b >= 243 {
+		return fmt.Errorf("%w: packed byte %d > 242", ErrInvalidBinary, b)
+	}
+	for i := 0; i < TritsPerByte; i++ {
+		g[i] = int8(b%3) - 1
+		b /= 3
+	}
+	return nil
+}
+
+// Упаковать триты, 5 тритов в байт
+func PackTrits(ts []Trit) []byte {
+	data := make([]byte, 0, (len(ts)+TritsPerByte-1)/TritsPerByte)
+	var g [TritsPerByte]int8
+	for i := 0; i < len(ts); i += TritsPerByte {
+		n := 0
+		for ; n < TritsPerByte && i+n < len(ts); n++ {
+			g[n] = ts[i+n].ToInt()
+		}
+		data = append(data, packGroup(g[:n]))
+	}
+	return data
+}
+
+// Распаковать n тритов из упакованных байт
+func UnpackTrits(data []byte, n int) ([]Trit, error) {
+	if n < 0 || n > len(data)*TritsPerByte {
+		return nil, fmt.Errorf("%w: %d trits in %d bytes", ErrInvalidBinary, n, len(data))
+	}
+	ts := make([]Trit, 0, n)
+	var g [TritsPerByte]int8
+	for _, b := range data {
+		if err := unpackGroup(b, &g); err != nil {
+			return nil, err
+		}
+		for i := 0; i < TritsPerByte && len(ts) < n; i++ {
+			ts = append(ts, IntToTrit(g[i]))
+		}
+	}
+	return ts, nil
+}
+
+// Запись потока тритов в io.Writer с плотной упаковкой
+type TritWriter struct {
+	w *bufio.Writer
+	g [TritsPerByte]int8 // текущая неполная группа
+	n int                // число тритов в группе
+}
+
+// Создать поток записи тритов
+func NewTritWriter(w io.Writer) *TritWriter {
+	return &TritWriter{w: bufio.NewWriter(w)}
+}
+
+// Записать трит
+func (tw *TritWriter) WriteTrit(t Trit) error {
+	tw.g[tw.n] = t.ToInt()
+	tw.n++
+	if tw.n < TritsPerByte {
+		return nil
+	}
+	tw.n = 0
+	return tw.w.WriteByte(packGroup(tw.g[:]))
+}
+
+// Записать триты троичного числа, начиная с младшего
+func (tw *TritWriter) WriteWord(x Word) error {
+	for i := uint8(0); i < x.l; i++ {
+		if err := tw.WriteTrit(x.Trit(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Дописать неполную группу (дополняется тритами 0) и сбросить буфер
+func (tw *TritWriter) Flush() error {
+	if tw.n > 0 {
+		if err := tw.w.WriteByte(packGroup(tw.g[:tw.n])); err != nil {
+			return err
+		}
+		tw.n = 0
+	}
+	return tw.w.Flush()
+}
+
+// Чтение потока тритов из io.Reader с плотной упаковкой
+type TritReader struct {
+	r io.ByteReader
+	g [TritsPerByte]int8 // текущая группа
+	n int                // число непрочитанных тритов группы
+}
+
+// Создать поток чтения тритов
+func NewTritReader(r io.Reader) *TritReader {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &TritReader{r: br}
+}
+
+// Прочитать трит; в конце потока возвращает io.EOF
+func (tr *TritReader) ReadTrit() (Trit, error) {
+	if tr.n == 0 {
+		b, err := tr.r.ReadByte()
+		if err != nil {
+			return Trit{}, err
+		}
+		if err := unpackGroup(b, &tr.g); err != nil {
+			return Trit{}, err
+		}
+		tr.n = TritsPerByte
+	}
+	t := IntToTrit(tr.g[TritsPerByte-tr.n])
+	tr.n--
+	return t, nil
+}
+
+// Прочитать троичное число длиной l тритов, начиная с младшего
+func (tr *TritReader) ReadWord(l uint8) (Word, error) {
+	x := NewWord(l)
+	for i := uint8(0); i < x.l; i++ {
+		t, err := tr.ReadTrit()
+		if err != nil {
+			if err == io.EOF && i > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return x, err
+		}
+		x = int2trs(x, i, t.ToInt())
+	}
+	return x, nil
+}
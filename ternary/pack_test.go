@@ -0,0 +1,64 @@
+package ternary
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+func Test_pack_trits(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 5, 6, 99, 1000} {
+		ts := make([]Trit, n)
+		for i := range ts {
+			ts[i] = IntToTrit(int8(rnd.Intn(3) - 1))
+		}
+		data := PackTrits(ts)
+		if len(data) != (n+4)/5 {
+			t.Errorf("PackTrits(%d trits) = %d bytes", n, len(data))
+		}
+		us, err := UnpackTrits(data, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range ts {
+			if us[i] != ts[i] {
+				t.Fatalf("UnpackTrits(%d)[%d] = %v, want %v", n, i, us[i], ts[i])
+			}
+		}
+	}
+	if _, err := UnpackTrits([]byte{243}, 5); !errors.Is(err, ErrInvalidBinary) {
+		t.Errorf("UnpackTrits(243): err = %v", err)
+	}
+}
+
+func Test_trit_stream(t *testing.T) {
+	var buf bytes.Buffer
+	tw := NewTritWriter(&buf)
+	var words []Word
+	for i := int64(-50); i < 50; i++ {
+		x, _ := FromInt64(i*193710, 18)
+		words = append(words, x)
+		if err := tw.WriteWord(x); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 100*18/5 {
+		t.Errorf("stream size = %d bytes, want %d", buf.Len(), 100*18/5)
+	}
+	tr := NewTritReader(&buf)
+	for _, want := range words {
+		x, err := tr.ReadWord(18)
+		if err != nil || x != want {
+			t.Fatalf("ReadWord = %v, %v; want %v", x, err, want)
+		}
+	}
+	if _, err := tr.ReadWord(18); err != io.EOF {
+		t.Errorf("ReadWord at end: err = %v, want EOF", err)
+	}
+}
@@ -0,0 +1,291 @@
+/**
+ * Filename: 	cnf.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+// Пакет sat проверяет выполнимость формул троичной логики.
+// Формула приводится к КНФ со знаковыми литералами: литерал x{S}
+// истинен, если значение переменной x принадлежит множеству S
+// (S ⊆ {-,0,+}), дизъюнкт истинен, если истинен хотя бы один его
+// литерал. Решатель — DPLL с распространением сужений доменов
+// переменных.
+package sat
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+	"github.com/askfind/goTernaryArithmetic/ternary/expr"
+)
+
+// Ошибка формата tcnf
+var ErrFormat = errors.New("sat: bad tcnf format")
+
+// Множество значений трита: бит 0 — '-', бит 1 — '0', бит 2 — '+'
+type Set uint8
+
+// Все значения трита
+const Full Set = 7
+
+// Множество из одного значения v = -1, 0, +1
+func Only(v int8) Set {
+	return 1 << uint(v+1)
+}
+
+// Принадлежит ли трит v множеству
+func (s Set) Has(v int8) bool {
+	return s&Only(v) != 0
+}
+
+// Число значений множества
+func (s Set) Len() int {
+	return int(s&1 + s>>1&1 + s>>2&1)
+}
+
+// Запись множества символами тритов: "-0", "+"
+func (s Set) String() string {
+	var sb strings.Builder
+	for v := int8(-1); v <= 1; v++ {
+		if s.Has(v) {
+			sb.WriteString(ternary.IntToTrit(v).SymbTrit())
+		}
+	}
+	return sb.String()
+}
+
+// Разобрать множество из символов '-','0','+'
+func parseSet(s string) (Set, bool) {
+	var r Set
+	for i := range s {
+		t, err := ternary.ParseTrit(s[i:i+1], ternary.AlphabetTrit)
+		if err != nil {
+			return 0, false
+		}
+		r |= Only(t.ToInt())
+	}
+	return r, r != 0
+}
+
+// Знаковый литерал: значение переменной Var принадлежит Set
+type Lit struct {
+	Var int
+	Set Set
+}
+
+// Дизъюнкт
+type Clause []Lit
+
+// КНФ над переменными 0..Vars-1
+type CNF struct {
+	Vars    int
+	Clauses []Clause
+}
+
+// Добавить новую переменную, вернуть ее номер
+func (c *CNF) NewVar() int {
+	c.Vars++
+	return c.Vars - 1
+}
+
+// Добавить дизъюнкт
+func (c *CNF) AddClause(lits ...Lit) {
+	for _, l := range lits {
+		if l.Var >= c.Vars {
+			c.Vars = l.Var + 1
+		}
+	}
+	c.Clauses = append(c.Clauses, append(Clause(nil), lits...))
+}
+
+// Добавить вентиль z = t(args...), вернуть номер переменной z
+// Для каждой строки таблицы добавляется дизъюнкт
+// args != строка или z = t(строка).
+func (c *CNF) Gate(t ternary.TruthTableN, args ...int) int {
+	z := c.NewVar()
+	tbl := t.Table()
+	for k, v := range tbl {
+		lits := make([]Lit, 0, len(args)+1)
+		for i, m := len(args)-1, k; i >= 0; i, m = i-1, m/3 {
+			lits = append(lits, Lit{args[i], Full &^ Only(int8(m%3)-1)})
+		}
+		c.AddClause(append(lits, Lit{z, Only(v)})...)
+	}
+	return z
+}
+
+// Вентиль z = f(x, y) для функции двух тритов
+func (c *CNF) Gate2(f ternary.TritFunc2, x int, y int) int {
+	return c.Gate(ternary.MakeTruthTable2(f).TableN(), x, y)
+}
+
+// Вентиль z = f(x) для функции одного трита
+func (c *CNF) Gate1(f ternary.TritFunc1, x int) int {
+	return c.Gate(ternary.MakeTruthTable1(f), x)
+}
+
+// Истинна ли КНФ при значениях переменных model
+func (c *CNF) Eval(model []int8) bool {
+	for _, cl := range c.Clauses {
+		ok := false
+		for _, l := range cl {
+			if l.Set.Has(model[l.Var]) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Преобразование Цейтина: КНФ, выполнимая тогда и только тогда, когда
+// формула e в семантике sem принимает значение target. Переменные
+// формулы Vars(e) получают номера 0, 1, ... в том же порядке.
+func Encode(e expr.Expr, sem *expr.Semantics, target int8) (*CNF, []string) {
+	vars := expr.Vars(e)
+	c := &CNF{Vars: len(vars)}
+	index := map[string]int{}
+	for i, v := range vars {
+		index[v] = i
+	}
+	imp := ternary.MakeTruthTable2(sem.Imp).TableN()
+	var enc func(e expr.Expr) int
+	enc = func(e expr.Expr) int {
+		switch e := e.(type) {
+		case *expr.Const:
+			z := c.NewVar()
+			c.AddClause(Lit{z, Only(e.Value.ToInt())})
+			return z
+		case *expr.Var:
+			return index[e.Name]
+		case *expr.Unary:
+			return c.Gate1(sem.Not, enc(e.X))
+		case *expr.Binary:
+			x, y := enc(e.X), enc(e.Y)
+			switch e.Op {
+			case expr.OpAnd:
+				return c.Gate2(sem.And, x, y)
+			case expr.OpOr:
+				return c.Gate2(sem.Or, x, y)
+			case expr.OpXor:
+				return c.Gate2(ternary.Xor, x, y)
+			case expr.OpImp:
+				return c.Gate(imp, x, y)
+			case expr.OpEqv:
+				return c.Gate2(sem.And, c.Gate(imp, x, y), c.Gate(imp, y, x))
+			}
+		case *expr.Call:
+			args := make([]int, len(e.Args))
+			for i, a := range e.Args {
+				args[i] = enc(a)
+			}
+			return c.Gate(e.Table, args...)
+		}
+		panic(fmt.Sprintf("sat: unsupported expression %v", e))
+	}
+	root := enc(e)
+	c.AddClause(Lit{root, Only(target)})
+	return c, vars
+}
+
+// ----------------------------------------------------
+// Формат tcnf (аналог DIMACS CNF)
+// ----------------------------------------------------
+//
+//	c комментарий
+//	p tcnf <переменных> <дизъюнктов>
+//	1/+ 2/-0 3/0- 0
+//
+// Литерал v/S: переменная v (с 1) принадлежит множеству S из символов
+// '-','0','+'; дизъюнкт завершается 0 и может занимать несколько строк.
+
+// Записать КНФ в формате tcnf
+func (c *CNF) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+	var n int64
+	k, _ := fmt.Fprintf(bw, "p tcnf %d %d\n", c.Vars, len(c.Clauses))
+	n += int64(k)
+	for _, cl := range c.Clauses {
+		for _, l := range cl {
+			k, _ = fmt.Fprintf(bw, "%d/%s ", l.Var+1, l.Set)
+			n += int64(k)
+		}
+		k, _ = bw.WriteString("0\n")
+		n += int64(k)
+	}
+	return n, bw.Flush()
+}
+
+// Прочитать КНФ в формате tcnf
+func Parse(r io.Reader) (*CNF, error) {
+	sc := bufio.NewScanner(r)
+	var c *CNF
+	var cl Clause
+	clauses := 0
+	for line := 1; sc.Scan(); line++ {
+		f := strings.Fields(sc.Text())
+		if len(f) == 0 || f[0] == "c" {
+			continue
+		}
+		if f[0] == "p" {
+			if c != nil || len(f) != 4 || f[1] != "tcnf" {
+				return nil, fmt.Errorf("%w: line %d: bad header", ErrFormat, line)
+			}
+			v, err1 := strconv.Atoi(f[2])
+			n, err2 := strconv.Atoi(f[3])
+			if err1 != nil || err2 != nil || v < 0 || n < 0 {
+				return nil, fmt.Errorf("%w: line %d: bad header", ErrFormat, line)
+			}
+			c = &CNF{Vars: v}
+			clauses = n
+			continue
+		}
+		if c == nil {
+			return nil, fmt.Errorf("%w: line %d: clause before header", ErrFormat, line)
+		}
+		for _, tok := range f {
+			if tok == "0" {
+				c.Clauses = append(c.Clauses, cl)
+				cl = nil
+				continue
+			}
+			i := strings.IndexByte(tok, '/')
+			if i < 0 {
+				return nil, fmt.Errorf("%w: line %d: bad literal %q", ErrFormat, line, tok)
+			}
+			v, err := strconv.Atoi(tok[:i])
+			s, ok := parseSet(tok[i+1:])
+			if err != nil || !ok || v < 1 || v > c.Vars {
+				return nil, fmt.Errorf("%w: line %d: bad literal %q", ErrFormat, line, tok)
+			}
+			cl = append(cl, Lit{v - 1, s})
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, fmt.Errorf("%w: missing header", ErrFormat)
+	}
+	if cl != nil {
+		return nil, fmt.Errorf("%w: unterminated clause", ErrFormat)
+	}
+	if len(c.Clauses) != clauses {
+		return nil, fmt.Errorf("%w: %d clauses, header says %d", ErrFormat, len(c.Clauses), clauses)
+	}
+	return c, nil
+}
@@ -0,0 +1,132 @@
+package sat
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/askfind/goTernaryArithmetic/ternary/expr"
+)
+
+func Test_solve_expr(t *testing.T) {
+	for _, c := range []struct {
+		src    string
+		sem    *expr.Semantics
+		target int8
+		sat    bool
+	}{
+		{"a | ~a", expr.Kleene, 1, true},
+		{"a & ~a", expr.Kleene, 1, false},
+		{"a & ~a", expr.Kleene, 0, true},
+		{"a -> a", expr.Kleene, 0, true},
+		{"a -> %true", expr.Kleene, 0, false},
+		{"(a -> b) & a & ~b", expr.Kleene, 0, true},
+		{"a -> a", expr.Lukasiewicz, 0, false},
+		{"a -> a", expr.Goedel, -1, false},
+		{"(a -> b) & a & ~b", expr.Lukasiewicz, 1, false},
+		{"Majority(a, b, c) & ~a & ~b", expr.Kleene, 1, false},
+		{"Webb(a, b) <-> %nil", expr.Kleene, 1, false},
+		{"Webb(a, b) <-> %nil", expr.Kleene, 0, true},
+	} {
+		env, ok := SolveExpr(expr.MustParse(c.src), c.sem, c.target)
+		if ok != c.sat {
+			t.Errorf("%s = %d (%s): sat = %v", c.src, c.target, c.sem.Name, ok)
+			continue
+		}
+		if ok {
+			if r, err := expr.MustParse(c.src).Eval(env, c.sem); err != nil || r.ToInt() != c.target {
+				t.Errorf("%s (%s): model %v gives %d", c.src, c.sem.Name, env, r.ToInt())
+			}
+		}
+	}
+}
+
+// Случайная формула от переменных a, b, c
+func randExpr(rnd *rand.Rand, depth int) string {
+	if depth == 0 || rnd.Intn(4) == 0 {
+		return []string{"a", "b", "c", "%nil", "%true"}[rnd.Intn(5)]
+	}
+	switch rnd.Intn(4) {
+	case 0:
+		return "~" + randExpr(rnd, depth-1)
+	case 1:
+		return "Webb(" + randExpr(rnd, depth-1) + ", " + randExpr(rnd, depth-1) + ")"
+	}
+	op := []string{"&", "|", "^", "->", "<->"}[rnd.Intn(5)]
+	return "(" + randExpr(rnd, depth-1) + " " + op + " " + randExpr(rnd, depth-1) + ")"
+}
+
+func Test_solve_random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 300; i++ {
+		e := expr.MustParse(randExpr(rnd, 5))
+		for _, sem := range expr.SemanticsAll {
+			tt, _, err := expr.TruthTable(e, sem)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for target := int8(-1); target <= 1; target++ {
+				want := strings.ContainsRune(tt.String(), rune("-0+"[target+1]))
+				env, ok := SolveExpr(e, sem, target)
+				if ok != want {
+					t.Fatalf("%v = %d (%s): sat = %v, table %v", e, target, sem.Name, ok, tt)
+				}
+				if ok {
+					if r, _ := e.Eval(env, sem); r.ToInt() != target {
+						t.Fatalf("%v (%s): bad model %v", e, sem.Name, env)
+					}
+				}
+			}
+		}
+	}
+}
+
+func Test_solve_pigeonhole(t *testing.T) {
+	// n переменных с попарно различными значениями: выполнимо при n <= 3
+	for n := 2; n <= 5; n++ {
+		c := &CNF{Vars: n}
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				for v := int8(-1); v <= 1; v++ {
+					c.AddClause(Lit{i, Full &^ Only(v)}, Lit{j, Full &^ Only(v)})
+				}
+			}
+		}
+		model, ok := Solve(c)
+		if ok != (n <= 3) || ok && !c.Eval(model) {
+			t.Errorf("pigeonhole %d: %v, %v", n, model, ok)
+		}
+	}
+}
+
+func Test_tcnf(t *testing.T) {
+	c, _ := Encode(expr.MustParse("a -> b"), expr.Kleene, 1)
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	d, err := Parse(strings.NewReader("c a -> b\n" + buf.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf2 bytes.Buffer
+	d.WriteTo(&buf2)
+	if buf.String() != buf2.String() {
+		t.Errorf("tcnf round trip:\n%s\n%s", buf.String(), buf2.String())
+	}
+	src := "p tcnf 2 2\n1/+ 2/-0\n 0\n2/+ 0\n"
+	// пример из описания формата
+	if c, err := Parse(strings.NewReader("p tcnf 3 1\n1/+ 2/-0 3/0- 0\n")); err != nil || len(c.Clauses) != 1 || c.Clauses[0][2] != (Lit{2, Only(-1) | Only(0)}) {
+		t.Errorf("Parse(doc example) = %v, %v", c, err)
+	}
+	if c, err := Parse(strings.NewReader(src)); err != nil || len(c.Clauses) != 2 || len(c.Clauses[0]) != 2 || c.Clauses[0][1].Set != Only(-1)|Only(0) {
+		t.Errorf("Parse(%q) = %v, %v", src, c, err)
+	}
+	for _, bad := range []string{"1/+ 0\n", "p tcnf 1 1\n2/+ 0\n", "p tcnf 1 1\n1/x 0\n", "p tcnf 1 2\n1/+ 0\n", "p tcnf 1 1\n1/+\n"} {
+		if _, err := Parse(strings.NewReader(bad)); !errors.Is(err, ErrFormat) {
+			t.Errorf("Parse(%q) error = %v", bad, err)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+/**
+ * Filename: 	solve.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package sat
+
+import (
+	"github.com/askfind/goTernaryArithmetic/ternary"
+	"github.com/askfind/goTernaryArithmetic/ternary/expr"
+)
+
+// ----------------------------------------------------
+// Решатель DPLL
+// ----------------------------------------------------
+
+// Статистика решателя
+type Stats struct {
+	Decisions    int // выборы значения переменной
+	Propagations int // сужения домена распространением
+	Conflicts    int // противоречия
+}
+
+type change struct {
+	v   int
+	old Set
+}
+
+type solver struct {
+	cnf   *CNF
+	dom   []Set   // допустимые значения переменных
+	occ   [][]int // дизъюнкты, содержащие переменную
+	trail []change
+	stats Stats
+}
+
+// Сузить домен переменной v до dom[v] & s
+func (s *solver) restrict(v int, set Set) (changed bool, ok bool) {
+	d := s.dom[v] & set
+	if d == s.dom[v] {
+		return false, true
+	}
+	s.trail = append(s.trail, change{v, s.dom[v]})
+	s.dom[v] = d
+	return true, d != 0
+}
+
+// Откатить изменения доменов до позиции n
+func (s *solver) undo(n int) {
+	for len(s.trail) > n {
+		c := s.trail[len(s.trail)-1]
+		s.dom[c.v] = c.old
+		s.trail = s.trail[:len(s.trail)-1]
+	}
+}
+
+// Распространение: дизъюнкт, у которого ложны все литералы, кроме
+// литералов одной переменной, сужает ее домен до объединения их множеств
+func (s *solver) propagate(queue []int) bool {
+	for len(queue) > 0 {
+		v := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+	clauses:
+		for _, ci := range s.occ[v] {
+			unit := -1
+			var set Set
+			for _, l := range s.cnf.Clauses[ci] {
+				d := s.dom[l.Var]
+				switch {
+				case d&^l.Set == 0:
+					continue clauses // литерал истинен
+				case d&l.Set == 0:
+					// литерал ложен
+				case unit == -1 || unit == l.Var:
+					unit = l.Var
+					set |= l.Set
+				default:
+					continue clauses // две неопределенные переменные
+				}
+			}
+			if unit == -1 {
+				s.stats.Conflicts++
+				return false
+			}
+			changed, ok := s.restrict(unit, set)
+			if !ok {
+				s.stats.Conflicts++
+				return false
+			}
+			if changed {
+				s.stats.Propagations++
+				queue = append(queue, unit)
+			}
+		}
+	}
+	return true
+}
+
+func (s *solver) search() bool {
+	// переменная с наименьшим неоднозначным доменом
+	best := -1
+	for v, d := range s.dom {
+		if d.Len() > 1 && (best < 0 || d.Len() < s.dom[best].Len()) {
+			best = v
+		}
+	}
+	if best < 0 {
+		return true
+	}
+	d := s.dom[best]
+	for _, val := range []int8{1, 0, -1} {
+		if !d.Has(val) {
+			continue
+		}
+		mark := len(s.trail)
+		s.stats.Decisions++
+		s.restrict(best, Only(val))
+		if s.propagate([]int{best}) && s.search() {
+			return true
+		}
+		s.undo(mark)
+	}
+	return false
+}
+
+// Найти значения переменных, при которых КНФ истинна
+// Возвращает модель и true или nil и false, если КНФ невыполнима.
+func Solve(c *CNF) ([]int8, bool) {
+	model, ok, _ := SolveStats(c)
+	return model, ok
+}
+
+// Solve со статистикой поиска
+func SolveStats(c *CNF) ([]int8, bool, Stats) {
+	s := &solver{cnf: c, dom: make([]Set, c.Vars), occ: make([][]int, c.Vars)}
+	queue := make([]int, 0, c.Vars)
+	for v := range s.dom {
+		s.dom[v] = Full
+		queue = append(queue, v)
+	}
+	for ci, cl := range c.Clauses {
+		if len(cl) == 0 {
+			return nil, false, s.stats
+		}
+		for _, l := range cl {
+			if n := len(s.occ[l.Var]); n == 0 || s.occ[l.Var][n-1] != ci {
+				s.occ[l.Var] = append(s.occ[l.Var], ci)
+			}
+		}
+	}
+	if !s.propagate(queue) || !s.search() {
+		return nil, false, s.stats
+	}
+	model := make([]int8, c.Vars)
+	for v, d := range s.dom {
+		for val := int8(-1); val <= 1; val++ {
+			if d.Has(val) {
+				model[v] = val
+			}
+		}
+	}
+	return model, true, s.stats
+}
+
+// Найти значения переменных, при которых формула e в семантике sem
+// равна target (%true = +1, %nil = 0, %false = -1)
+func SolveExpr(e expr.Expr, sem *expr.Semantics, target int8) (expr.Env, bool) {
+	c, vars := Encode(e, sem, target)
+	model, ok := Solve(c)
+	if !ok {
+		return nil, false
+	}
+	env := make(expr.Env, len(vars))
+	for i, v := range vars {
+		env[v] = ternary.IntToTrit(model[i])
+	}
+	return env, true
+}
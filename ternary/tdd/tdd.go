@@ -0,0 +1,454 @@
+/**
+ * Filename: 	tdd.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+// Пакет tdd реализует сокращенные упорядоченные троичные диаграммы
+// решений (TDD, MDD с тремя ветвями). Узел проверяет переменную x[i]
+// и имеет три потомка для x[i] = -, 0, +; листья — константы -, 0, +.
+// Узлы хранятся в таблице уникальности (hash-consing), поэтому равные
+// функции представлены одним и тем же узлом и сравниваются как целые.
+package tdd
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/askfind/goTernaryArithmetic/ternary"
+	"github.com/askfind/goTernaryArithmetic/ternary/expr"
+)
+
+// Узел диаграммы (номер в таблице узлов менеджера)
+type Node int32
+
+// Листья: константы -, 0, +
+const (
+	False Node = 0
+	Nil   Node = 1
+	True  Node = 2
+)
+
+// Значение переменной в решении, не влияющее на функцию
+const DontCare int8 = 2
+
+type node struct {
+	level int32   // номер переменной, у листьев — число переменных
+	ch    [3]Node // потомки для x = -, 0, +
+}
+
+type applyKey struct {
+	t    ternary.TruthTable2
+	f, g Node
+}
+
+// Менеджер диаграмм над переменными x[0] < x[1] < ... < x[n-1]
+type Manager struct {
+	n      int
+	nodes  []node
+	unique map[node]Node
+	apply  map[applyKey]Node
+}
+
+// Создать менеджер диаграмм функций n тритов
+func New(n int) *Manager {
+	m := &Manager{
+		n:      n,
+		unique: map[node]Node{},
+		apply:  map[applyKey]Node{},
+	}
+	for i := 0; i < 3; i++ {
+		m.nodes = append(m.nodes, node{level: int32(n)})
+	}
+	return m
+}
+
+// Число переменных
+func (m *Manager) Vars() int {
+	return m.n
+}
+
+// Число узлов в таблице менеджера (включая 3 листа)
+func (m *Manager) NodeCount() int {
+	return len(m.nodes)
+}
+
+// Лист-константа v = -1, 0, +1
+func (m *Manager) Const(v int8) Node {
+	return Node(ternary.IntToTrit(v).ToInt() + 1)
+}
+
+// Является ли узел листом
+func (m *Manager) IsConst(f Node) bool {
+	return f <= True
+}
+
+// Значение листа
+func (m *Manager) Value(f Node) int8 {
+	if !m.IsConst(f) {
+		panic("tdd: Value of non-constant node")
+	}
+	return int8(f) - 1
+}
+
+// Номер переменной узла (число переменных для листа)
+func (m *Manager) Level(f Node) int {
+	return int(m.nodes[f].level)
+}
+
+// Потомок узла для значения переменной v
+func (m *Manager) Child(f Node, v int8) Node {
+	return m.nodes[f].ch[v+1]
+}
+
+// Найти или создать узел (сокращение: равные потомки заменяют узел)
+func (m *Manager) mk(level int, ch [3]Node) Node {
+	if ch[0] == ch[1] && ch[1] == ch[2] {
+		return ch[0]
+	}
+	k := node{int32(level), ch}
+	if r, ok := m.unique[k]; ok {
+		return r
+	}
+	r := Node(len(m.nodes))
+	m.nodes = append(m.nodes, k)
+	m.unique[k] = r
+	return r
+}
+
+func (m *Manager) checkVar(i int) {
+	if i < 0 || i >= m.n {
+		panic(fmt.Sprintf("tdd: variable %d out of range [0, %d)", i, m.n))
+	}
+}
+
+// Функция f(x) = x[i]
+func (m *Manager) Var(i int) Node {
+	m.checkVar(i)
+	return m.mk(i, [3]Node{False, Nil, True})
+}
+
+// Литерал-окно: + при x[i] из set (по значениям -1, 0, +1), иначе -
+func (m *Manager) Window(i int, set ...int8) Node {
+	ch := [3]Node{False, False, False}
+	for _, v := range set {
+		ch[v+1] = True
+	}
+	m.checkVar(i)
+	return m.mk(i, ch)
+}
+
+// Потомки узла f по переменной level (f не зависит от нее — три раза f)
+func (m *Manager) cofactors(f Node, level int32) [3]Node {
+	if m.nodes[f].level == level {
+		return m.nodes[f].ch
+	}
+	return [3]Node{f, f, f}
+}
+
+// Поразрядное применение функции двух тритов: t(f(x), g(x))
+func (m *Manager) Apply(t ternary.TruthTable2, f Node, g Node) Node {
+	if m.IsConst(f) && m.IsConst(g) {
+		return m.Const(t.At(m.Value(f), m.Value(g)))
+	}
+	k := applyKey{t, f, g}
+	if r, ok := m.apply[k]; ok {
+		return r
+	}
+	level := m.nodes[f].level
+	if l := m.nodes[g].level; l < level {
+		level = l
+	}
+	fc, gc := m.cofactors(f, level), m.cofactors(g, level)
+	var ch [3]Node
+	for v := range ch {
+		ch[v] = m.Apply(t, fc[v], gc[v])
+	}
+	r := m.mk(int(level), ch)
+	m.apply[k] = r
+	return r
+}
+
+// Применение функции двух тритов пакета ternary
+func (m *Manager) ApplyFunc(f ternary.TritFunc2, a Node, b Node) Node {
+	return m.Apply(ternary.MakeTruthTable2(f), a, b)
+}
+
+// Поразрядное применение функции одного трита: f(a(x))
+func (m *Manager) Map(f ternary.TritFunc1, a Node) Node {
+	// f(a) = F(a, a), где F(a, b) = f(a)
+	t := ternary.MakeTruthTable2(func(a ternary.Trit, b ternary.Trit) ternary.Trit { return f(a) })
+	return m.Apply(t, a, a)
+}
+
+// Операции пакета ternary над диаграммами
+var (
+	tableAnd = ternary.MakeTruthTable2(ternary.And)
+	tableOr  = ternary.MakeTruthTable2(ternary.Or)
+	tableXor = ternary.MakeTruthTable2(ternary.Xor)
+	tableImp = ternary.MakeTruthTable2(ternary.Imp)
+)
+
+// MIN(a, b)
+func (m *Manager) And(a Node, b Node) Node { return m.Apply(tableAnd, a, b) }
+
+// MAX(a, b)
+func (m *Manager) Or(a Node, b Node) Node { return m.Apply(tableOr, a, b) }
+
+// Xor(a, b)
+func (m *Manager) Xor(a Node, b Node) Node { return m.Apply(tableXor, a, b) }
+
+// Imp(a, b)
+func (m *Manager) Imp(a Node, b Node) Node { return m.Apply(tableImp, a, b) }
+
+// Not(a)
+func (m *Manager) Not(a Node) Node { return m.Map(ternary.Not, a) }
+
+// Подстановка x[i] = v
+func (m *Manager) Restrict(f Node, i int, v int8) Node {
+	memo := map[Node]Node{}
+	var rec func(f Node) Node
+	rec = func(f Node) Node {
+		n := m.nodes[f]
+		if int(n.level) > i {
+			return f
+		}
+		if int(n.level) == i {
+			return n.ch[v+1]
+		}
+		if r, ok := memo[f]; ok {
+			return r
+		}
+		var ch [3]Node
+		for k := range ch {
+			ch[k] = rec(n.ch[k])
+		}
+		r := m.mk(int(n.level), ch)
+		memo[f] = r
+		return r
+	}
+	return rec(f)
+}
+
+// Квантор существования: MAX по значениям x[i]
+func (m *Manager) Exists(f Node, i int) Node {
+	return m.Or(m.Or(m.Restrict(f, i, -1), m.Restrict(f, i, 0)), m.Restrict(f, i, 1))
+}
+
+// Квантор всеобщности: MIN по значениям x[i]
+func (m *Manager) Forall(f Node, i int) Node {
+	return m.And(m.And(m.Restrict(f, i, -1), m.Restrict(f, i, 0)), m.Restrict(f, i, 1))
+}
+
+// Значение функции при значениях переменных args
+func (m *Manager) Eval(f Node, args ...int8) int8 {
+	for !m.IsConst(f) {
+		n := m.nodes[f]
+		f = n.ch[args[n.level]+1]
+	}
+	return m.Value(f)
+}
+
+// Число узлов диаграммы f (включая листья)
+func (m *Manager) Size(f Node) int {
+	seen := map[Node]bool{}
+	var rec func(f Node)
+	rec = func(f Node) {
+		if seen[f] {
+			return
+		}
+		seen[f] = true
+		if !m.IsConst(f) {
+			for _, c := range m.nodes[f].ch {
+				rec(c)
+			}
+		}
+	}
+	rec(f)
+	return len(seen)
+}
+
+// Число наборов значений всех переменных, на которых f = v
+func (m *Manager) SatCount(f Node, v int8) *big.Int {
+	memo := map[Node]*big.Int{}
+	three := big.NewInt(3)
+	pow := func(k int32) *big.Int {
+		return new(big.Int).Exp(three, big.NewInt(int64(k)), nil)
+	}
+	// число наборов переменных с номерами >= level узла
+	var rec func(f Node) *big.Int
+	rec = func(f Node) *big.Int {
+		if m.IsConst(f) {
+			if m.Value(f) == v {
+				return big.NewInt(1)
+			}
+			return big.NewInt(0)
+		}
+		if r, ok := memo[f]; ok {
+			return r
+		}
+		n := m.nodes[f]
+		r := new(big.Int)
+		for _, c := range n.ch {
+			r.Add(r, new(big.Int).Mul(rec(c), pow(m.nodes[c].level-n.level-1)))
+		}
+		memo[f] = r
+		return r
+	}
+	return new(big.Int).Mul(rec(f), pow(m.nodes[f].level))
+}
+
+// Перебрать решения f = v в виде кубов: значения переменных -1, 0, +1
+// или DontCare. Перебор прекращается, если fn возвращает false.
+// Срез cube переиспользуется между вызовами fn.
+func (m *Manager) ForEachSat(f Node, v int8, fn func(cube []int8) bool) {
+	cube := make([]int8, m.n)
+	for i := range cube {
+		cube[i] = DontCare
+	}
+	var rec func(f Node) bool
+	rec = func(f Node) bool {
+		if m.IsConst(f) {
+			if m.Value(f) != v {
+				return true
+			}
+			return fn(cube)
+		}
+		n := m.nodes[f]
+		for k, c := range n.ch {
+			cube[n.level] = int8(k) - 1
+			if !rec(c) {
+				return false
+			}
+		}
+		cube[n.level] = DontCare
+		return true
+	}
+	rec(f)
+}
+
+// Одно решение f = v (переменные вне куба равны 0)
+func (m *Manager) AnySat(f Node, v int8) ([]int8, bool) {
+	var r []int8
+	m.ForEachSat(f, v, func(cube []int8) bool {
+		r = make([]int8, len(cube))
+		for i, x := range cube {
+			if x != DontCare {
+				r[i] = x
+			}
+		}
+		return false
+	})
+	return r, r != nil
+}
+
+// Диаграмма функции, заданной таблицей истинности
+// (первый аргумент — x[0])
+func (m *Manager) FromTruthTable(t ternary.TruthTableN) Node {
+	tbl := t.Table()
+	var rec func(level int, base int, size int) Node
+	rec = func(level int, base int, size int) Node {
+		if level == t.Arity() {
+			return m.Const(tbl[base])
+		}
+		size /= 3
+		var ch [3]Node
+		for v := range ch {
+			ch[v] = rec(level+1, base+v*size, size)
+		}
+		return m.mk(level, ch)
+	}
+	return rec(0, 0, len(tbl))
+}
+
+// Диаграмма формулы в семантике sem; vars[i] — имя переменной x[i]
+func (m *Manager) FromExpr(e expr.Expr, vars []string, sem *expr.Semantics) (Node, error) {
+	index := map[string]int{}
+	for i, v := range vars {
+		index[v] = i
+	}
+	var rec func(e expr.Expr) (Node, error)
+	rec = func(e expr.Expr) (Node, error) {
+		switch e := e.(type) {
+		case *expr.Const:
+			return m.Const(e.Value.ToInt()), nil
+		case *expr.Var:
+			i, ok := index[e.Name]
+			if !ok {
+				return 0, fmt.Errorf("%w %s", expr.ErrUnbound, e.Name)
+			}
+			return m.Var(i), nil
+		case *expr.Unary:
+			x, err := rec(e.X)
+			if err != nil {
+				return 0, err
+			}
+			return m.Map(sem.Not, x), nil
+		case *expr.Binary:
+			x, err := rec(e.X)
+			if err != nil {
+				return 0, err
+			}
+			y, err := rec(e.Y)
+			if err != nil {
+				return 0, err
+			}
+			switch e.Op {
+			case expr.OpAnd:
+				return m.ApplyFunc(sem.And, x, y), nil
+			case expr.OpOr:
+				return m.ApplyFunc(sem.Or, x, y), nil
+			case expr.OpXor:
+				return m.Xor(x, y), nil
+			case expr.OpImp:
+				return m.ApplyFunc(sem.Imp, x, y), nil
+			case expr.OpEqv:
+				return m.ApplyFunc(sem.And, m.ApplyFunc(sem.Imp, x, y), m.ApplyFunc(sem.Imp, y, x)), nil
+			}
+		case *expr.Call:
+			args := make([]Node, len(e.Args))
+			for i, a := range e.Args {
+				var err error
+				if args[i], err = rec(a); err != nil {
+					return 0, err
+				}
+			}
+			return m.compose(e.Table, args), nil
+		}
+		return 0, fmt.Errorf("tdd: unsupported expression %v", e)
+	}
+	return rec(e)
+}
+
+// Суперпозиция t(args[0](x), ..., args[k-1](x)) разбором по первому
+// аргументу: t = MAX по v из MIN(args[0] == v, t|a0=v (...))
+func (m *Manager) compose(t ternary.TruthTableN, args []Node) Node {
+	if len(args) == 0 {
+		return m.Const(t.At())
+	}
+	if len(args) == 2 {
+		tt, _ := t.TruthTable2()
+		return m.Apply(tt, args[0], args[1])
+	}
+	tbl := t.Table()
+	size := len(tbl) / 3
+	r := False
+	for v := int8(-1); v <= 1; v++ {
+		sub := ternary.TruthTableNOf(len(args)-1, tbl[int(v+1)*size:int(v+2)*size])
+		// +, если args[0] == v, иначе -
+		is := m.Map(func(a ternary.Trit) (r ternary.Trit) {
+			if a.ToInt() == v {
+				return r.SetTrue()
+			}
+			return r.SetFalse()
+		}, args[0])
+		r = m.Or(r, m.And(is, m.compose(sub, args[1:])))
+	}
+	return r
+}
@@ -0,0 +1,106 @@
+package tdd
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/askfind/goTernaryArithmetic/ternary/expr"
+)
+
+func Test_tdd_canonical(t *testing.T) {
+	m := New(3)
+	a, b, c := m.Var(0), m.Var(1), m.Var(2)
+	if m.And(a, b) != m.And(b, a) || m.Or(m.And(a, b), c) != m.Or(c, m.And(b, a)) {
+		t.Errorf("And/Or are not canonical")
+	}
+	// двойное отрицание
+	if m.Not(m.Not(m.Xor(a, c))) != m.Xor(a, c) {
+		t.Errorf("Not(Not(f)) != f")
+	}
+	// a & ~a не тождественно ложна в троичной логике: 0 при a = 0
+	if f := m.And(a, m.Not(a)); f == False || m.Eval(f, 0, 0, 0) != 0 {
+		t.Errorf("a & ~a = %d", m.Eval(f, 0, 0, 0))
+	}
+	if m.Exists(m.And(a, b), 1) != a || m.Forall(m.Or(a, b), 1) != a {
+		t.Errorf("Exists/Forall")
+	}
+	if m.Restrict(m.Imp(a, b), 0, -1) != True || m.Restrict(m.Imp(a, b), 0, 1) != b {
+		t.Errorf("Restrict")
+	}
+	if w := m.Window(1, -1, 1); m.Eval(w, 0, 0, 0) != -1 || m.Eval(w, 0, 1, 0) != 1 {
+		t.Errorf("Window")
+	}
+}
+
+func Test_tdd_expr(t *testing.T) {
+	for _, src := range []string{
+		"a & ~b | c",
+		"(a -> b) <-> (~b -> ~a)",
+		"Webb(a, b) ^ Majority(a, b, c)",
+		"Mux(a, b, c, %nil)",
+	} {
+		for _, sem := range expr.SemanticsAll {
+			e := expr.MustParse(src)
+			want, vars, _ := expr.TruthTable(e, sem)
+			m := New(len(vars))
+			f, err := m.FromExpr(e, vars, sem)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if g := m.FromTruthTable(want); g != f {
+				t.Errorf("%s (%s): FromExpr != FromTruthTable", src, sem.Name)
+			}
+			args := make([]int8, len(vars))
+			for k, w := range want.Table() {
+				for i, r := len(vars)-1, k; i >= 0; i, r = i-1, r/3 {
+					args[i] = int8(r%3) - 1
+				}
+				if v := m.Eval(f, args...); v != w {
+					t.Errorf("%s (%s) row %d = %d, want %d", src, sem.Name, k, v, w)
+				}
+			}
+		}
+	}
+}
+
+func Test_tdd_large(t *testing.T) {
+	const n = 16
+	m := New(n)
+	// MAX всех переменных
+	f := False
+	for i := 0; i < n; i++ {
+		f = m.Or(f, m.Var(i))
+	}
+	if s := m.Size(f); s != 2*n+2 {
+		t.Errorf("Size = %d", s)
+	}
+	three := new(big.Int).Exp(big.NewInt(3), big.NewInt(n), nil)
+	two := new(big.Int).Exp(big.NewInt(2), big.NewInt(n), nil)
+	if c := m.SatCount(f, 1); c.Cmp(new(big.Int).Sub(three, two)) != 0 {
+		t.Errorf("SatCount(+) = %v", c)
+	}
+	if c := m.SatCount(f, -1); c.Int64() != 1 {
+		t.Errorf("SatCount(-) = %v", c)
+	}
+	// решения f = 0: кубы покрывают 2^n - 1 набор
+	total := new(big.Int)
+	m.ForEachSat(f, 0, func(cube []int8) bool {
+		k := int64(1)
+		for _, v := range cube {
+			if v == DontCare {
+				k *= 3
+			}
+		}
+		total.Add(total, big.NewInt(k))
+		return true
+	})
+	if total.Cmp(m.SatCount(f, 0)) != 0 || total.Int64() != 1<<n-1 {
+		t.Errorf("ForEachSat = %v", total)
+	}
+	if s, ok := m.AnySat(f, -1); !ok || m.Eval(f, s...) != -1 {
+		t.Errorf("AnySat = %v, %v", s, ok)
+	}
+	if _, ok := m.AnySat(m.And(m.Var(0), m.Not(m.Var(0))), 1); ok {
+		t.Errorf("AnySat(a & ~a = +)")
+	}
+}
@@ -0,0 +1,397 @@
+package ternary
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func Test_pow3(t *testing.T) {
+	want := new(big.Int).SetInt64(1)
+	for i := int8(0); i <= Pow3Max; i++ {
+		if Pow3(i) != want.Int64() || !want.IsInt64() {
+			t.Errorf("Pow3(%d) = %d, want %v", i, Pow3(i), want)
+		}
+		want.Mul(want, big.NewInt(3))
+	}
+	if want.IsInt64() {
+		t.Errorf("3^%d fits in int64", Pow3Max+1)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Pow3(%d): no panic", Pow3Max+1)
+		}
+	}()
+	Pow3(Pow3Max + 1)
+}
+
+func Test_add_trs(t *testing.T) {
+	// (+) + (+) = (+-)
+	x := NewWord(3).SetTrue(0)
+	y := NewWord(3).SetTrue(0)
+	r := x.Add(y)
+	if r.GetTrit(0) != -1 || r.GetTrit(1) != 1 || r.GetTrit(2) != 0 {
+		t.Errorf("add_trs: %s%s%s", r.Symb(2), r.Symb(1), r.Symb(0))
+	}
+	// (+-) - (+) = (00+)
+	r = r.Sub(y)
+	if r.GetTrit(0) != 1 || r.GetTrit(1) != 0 || r.GetTrit(2) != 0 {
+		t.Errorf("sub_trs: %s%s%s", r.Symb(2), r.Symb(1), r.Symb(0))
+	}
+}
+
+func Test_add_carry_trs(t *testing.T) {
+	const l = 4 // диапазон -40..40
+	var c Trit
+	for _, v := range [][2]int64{{40, 1}, {-40, -1}, {20, 20}, {-20, 5}} {
+		x, y := wordOf(v[0], l), wordOf(v[1], l)
+		r, carry, ovf := x.AddCarry(y, c.SetNil())
+		if valueOf(r)+int64(carry.ToInt())*81 != v[0]+v[1] || ovf != (carry.ToInt() != 0) {
+			t.Errorf("AddCarry(%d, %d) = %d, %d, %v", v[0], v[1], valueOf(r), carry.ToInt(), ovf)
+		}
+		w, ovfw := x.AddWrap(y)
+		if w != r || ovfw != ovf {
+			t.Errorf("AddWrap(%d, %d) = %d, %v", v[0], v[1], valueOf(w), ovfw)
+		}
+		s, ovfs := x.AddSat(y)
+		want := v[0] + v[1]
+		if want > 40 {
+			want = 40
+		} else if want < -40 {
+			want = -40
+		}
+		if valueOf(s) != want || ovfs != ovf {
+			t.Errorf("AddSat(%d, %d) = %d, %v", v[0], v[1], valueOf(s), ovfs)
+		}
+	}
+	r, carry, ovf := wordOf(-40, l).SubCarry(wordOf(1, l), c.SetFalse())
+	if valueOf(r) != 39 || !carry.IsFalse() || !ovf {
+		t.Errorf("SubCarry(-40, 1, -1) = %d, %d, %v", valueOf(r), carry.ToInt(), ovf)
+	}
+}
+
+func Test_add_trs_prefix(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		lx, ly := uint8(rnd.Intn(TritsMax+1)), uint8(rnd.Intn(TritsMax+1))
+		x, y := randWord(rnd, lx), randWord(rnd, ly)
+		p0 := int8(rnd.Intn(3) - 1)
+		sy := int8(1 - 2*rnd.Intn(2))
+		r, c := addTrs(x, y, sy, p0)
+		wr, wc := addTrsSlowly(x, y, sy, p0)
+		if r != wr || c != wc {
+			t.Fatalf("addTrs(%v, %v, %d, %d) = %v, %d; want %v, %d", x, y, sy, p0, r, c, wr, wc)
+		}
+	}
+}
+
+// Троичное число длиной l из int64 (для тестов)
+func wordOf(n int64, l uint8) Word {
+	return NewBigWord(n).wordAt(0, l)
+}
+
+// Значение троичного числа (для тестов)
+func valueOf(w Word) int64 {
+	return new(BigWord).SetWord(w).Int64()
+}
+
+func Test_mul_trs(t *testing.T) {
+	const l = 9
+	for _, c := range [][2]int64{{0, 5}, {1, -1}, {9841, 9841}, {-9841, 9841}, {123, -4567}} {
+		hi, lo := wordOf(c[0], l).Mul(wordOf(c[1], l))
+		if got := valueOf(hi)*19683 + valueOf(lo); got != c[0]*c[1] {
+			t.Errorf("mul_trs(%d, %d) = %d", c[0], c[1], got)
+		}
+	}
+}
+
+func Test_divmod_trs(t *testing.T) {
+	const l = 9
+	for _, c := range [][2]int64{{100, 7}, {-100, 7}, {100, -7}, {9841, 2}, {4, 8}, {-4, 8}} {
+		q, r, ph1, ph2 := DivMod(NewWord(l), wordOf(c[0], l), wordOf(c[1], l))
+		if !ph1.IsNil() || !ph2.IsNil() {
+			t.Errorf("divmod_trs(%d, %d): overflow", c[0], c[1])
+		}
+		y := c[1]
+		if y < 0 {
+			y = -y
+		}
+		if valueOf(q)*c[1]+valueOf(r) != c[0] || 2*valueOf(r) > y || 2*valueOf(r) < -y {
+			t.Errorf("divmod_trs(%d, %d) = %d, %d", c[0], c[1], valueOf(q), valueOf(r))
+		}
+	}
+	// 3^9 / 1 не помещается в 9 тритов: ph1 = +
+	q, _, ph1, ph2 := DivMod(wordOf(1, l), NewWord(l), wordOf(1, l))
+	if !ph1.IsTrue() || !ph2.IsNil() || valueOf(q) != 0 {
+		t.Errorf("divmod_trs overflow: q=%d ph1=%d ph2=%d", valueOf(q), ph1.ToInt(), ph2.ToInt())
+	}
+}
+
+func Benchmark_pow3(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Pow3(31)
+	}
+}
+
+func Benchmark_shift_ts(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var x Word
+		shiftTs(x, -31)
+	}
+}
+
+func Benchmark_add_half_slowly_t(b *testing.B) {
+	var aa Trit
+	var bb Trit
+	aa = aa.SetFalse()
+	bb = bb.SetFalse()
+	for i := 0; i < b.N; i++ {
+		AddHalfSlowly(aa, bb)
+	}
+}
+
+func Benchmark_add_full_t(b *testing.B) {
+	var aa Trit
+	var bb Trit
+	var cc Trit
+	aa = aa.SetFalse()
+	bb = bb.SetFalse()
+	cc = cc.SetFalse()
+	for i := 0; i < b.N; i++ {
+		AddFull(aa, bb, cc)
+	}
+}
+
+func Benchmark_mul_t(b *testing.B) {
+	var aa Trit
+	var bb Trit
+	for i := 0; i < b.N; i++ {
+		Mul(aa.SetFalse(), bb.SetTrue())
+	}
+}
+
+func Benchmark_sum_t(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sumT(1, 1, 1)
+	}
+}
+
+func Benchmark_get_trit(b *testing.B) {
+
+	var t1 uint32
+	var t0 uint32
+	var p uint8
+
+	p = 0
+	t1 = t1 &^ (1 << p)
+	t0 |= (1 << p)
+
+	for i := 0; i < b.N; i++ {
+		getTrit(t1, t0, p)
+	}
+}
+
+func Benchmark_sgn_trs(b *testing.B) {
+	var x Word
+	x.l = 32
+	for i := 0; i < b.N; i++ {
+		x.Sgn()
+	}
+}
+
+func Benchmark_shift_trs(b *testing.B) {
+	var x Word
+	x.l = 32
+	for i := 0; i < b.N; i++ {
+		x = x.Shift(-31)
+	}
+}
+
+func Benchmark_add_trs(b *testing.B) {
+	var x Word
+	var y Word
+	x.l = 32
+	y.l = 32
+	for i := 0; i < b.N; i++ {
+		x.Add(y)
+	}
+}
+
+func Benchmark_add_trs_slowly(b *testing.B) {
+	var x Word
+	var y Word
+	x.l = 32
+	y.l = 32
+	for i := 0; i < b.N; i++ {
+		addTrsSlowly(x, y, 1, 0)
+	}
+}
+
+func Benchmark_sub_trs(b *testing.B) {
+	var x Word
+	var y Word
+	x.l = 32
+	y.l = 32
+	for i := 0; i < b.N; i++ {
+		x.Sub(y)
+	}
+}
+
+func Benchmark_mul_trs(b *testing.B) {
+	x := wordOf(-9841, 18)
+	y := wordOf(7381, 18)
+	for i := 0; i < b.N; i++ {
+		x.Mul(y)
+	}
+}
+
+func Benchmark_int2trs(b *testing.B) {
+	var x Word
+	x.l = 32
+	for i := 0; i < b.N; i++ {
+		int2trs(x, 31, -1)
+	}
+}
+
+func Benchmark_trs2int(b *testing.B) {
+	var x Word
+	x.l = 32
+	for i := 0; i < b.N; i++ {
+		trs2int(x, 31)
+	}
+}
+
+//func BenchmarkCalculate(b *testing.B) {
+//	for i := 0; i < b.N; i++ {
+//	}
+//}
+
+///func BenchmarkSample(b *testing.B) {
+//    b.SetBytes(2)
+//    for i := 0; i < b.N; i++ {
+//        if x := fmt.Sprintf("%d", 42); x != "42" {
+//            b.Fatalf("Unexpected string: %s", x)
+//        }
+//    }
+//}
+
+// ----------------------------------------------------
+// Исправления, внесенные при переносе в пакет ternary
+// ----------------------------------------------------
+
+// MulSlowly: для (-, +) возвращался 0 (повторная ветка (-, -))
+func Test_mul_slowly_t(t *testing.T) {
+	for a := int8(-1); a <= 1; a++ {
+		for b := int8(-1); b <= 1; b++ {
+			if r := MulSlowly(IntToTrit(a), IntToTrit(b)); r.ToInt() != a*b {
+				t.Errorf("MulSlowly(%d, %d) = %d", a, b, r.ToInt())
+			}
+		}
+	}
+}
+
+// sumT: для суммы -3 перенос был +1 вместо -1
+func Test_sum_t(t *testing.T) {
+	for a := int8(-1); a <= 1; a++ {
+		for b := int8(-1); b <= 1; b++ {
+			for p := int8(-1); p <= 1; p++ {
+				if s, c := sumT(a, b, p); s+3*c != a+b+p {
+					t.Errorf("sumT(%d, %d, %d) = %d, %d", a, b, p, s, c)
+				}
+			}
+		}
+	}
+}
+
+// GetTrit возвращал 0 вместо -1, SetTrit всегда записывал 0,
+// позиция p = 32 выходила за поле тритов
+func Test_get_set_trit(t *testing.T) {
+	for p := uint8(0); p < TritsMax; p++ {
+		for v := int8(-1); v <= 1; v++ {
+			if got := NewWord(TritsMax).SetTrit(p, v).GetTrit(p); got != v {
+				t.Errorf("SetTrit(%d, %d).GetTrit() = %d", p, v, got)
+			}
+		}
+	}
+	w := NewWord(TritsMax)
+	if w.SetTrit(TritsMax, 1) != w || w.SetTrue(TritsMax) != w || w.GetTrit(TritsMax) != 0 {
+		t.Errorf("trit %d out of range is not ignored", TritsMax)
+	}
+	// позиции за длиной слова не оставляют скрытых тритов
+	w3 := NewWord(3)
+	for _, x := range []Word{w3.SetTrit(5, 1), w3.SetTrue(3), w3.SetFalse(4), w3.SetNil(31), w3.Clear(7)} {
+		if x != w3 {
+			t.Errorf("write past length 3 changed word: %+v", x)
+		}
+		b, _ := x.MarshalBinary()
+		var y Word
+		if err := y.UnmarshalBinary(b); err != nil || y != x {
+			t.Errorf("binary round trip %+v = %+v, %v", x, y, err)
+		}
+	}
+}
+
+// Symb: символ определялся по всему слову, а не по триту p
+func Test_symb(t *testing.T) {
+	w := NewWord(3).SetFalse(1).SetTrue(2)
+	if s := w.Symb(2) + w.Symb(1) + w.Symb(0); s != "+-0" {
+		t.Errorf("Symb = %s, want +-0", s)
+	}
+}
+
+// Add/Sub: слагаемые сдвигались в цикле и читались тритами 0, 2, 4, ...;
+// длина ограничивалась 31 тритом
+func Test_add_sub_len(t *testing.T) {
+	for _, c := range [][2]int64{{5, 7}, {-40, 13}, {100, -99}} {
+		x, y := wordOf(c[0], 6), wordOf(c[1], 6)
+		if got := valueOf(x.Add(y)); got != c[0]+c[1] {
+			t.Errorf("%d + %d = %d", c[0], c[1], got)
+		}
+		if got := valueOf(x.Sub(y)); got != c[0]-c[1] {
+			t.Errorf("%d - %d = %d", c[0], c[1], got)
+		}
+	}
+	top := NewWord(TritsMax).SetTrue(TritsMax - 1)
+	if r := top.Add(NewWord(TritsMax)); r.GetTrit(TritsMax-1) != 1 {
+		t.Errorf("trit %d is lost in Add", TritsMax-1)
+	}
+}
+
+// And/Or/Xor: результат имел длину 0; Shift оставлял триты за длиной;
+// ClearFull не очищал поле t1
+func Test_word_len(t *testing.T) {
+	x, y := NewWord(2).SetTrue(0), NewWord(5).SetFalse(4)
+	for name, r := range map[string]Word{"And": x.And(y), "Or": x.Or(y), "Xor": x.Xor(y)} {
+		if r.Len() != 5 {
+			t.Errorf("%s: Len() = %d, want 5", name, r.Len())
+		}
+	}
+	if r := NewWord(3).SetTrue(2).Shift(-1); r.Len() != 3 || r.GetTrit(3) != 0 {
+		t.Errorf("Shift(-1) = %d trits, trit 3 = %d", r.Len(), r.GetTrit(3))
+	}
+	w := NewWord(3).SetTrue(1)
+	w.ClearFull()
+	if w != (Word{}) {
+		t.Errorf("ClearFull() = %+v", w)
+	}
+}
+
+// And: для (0, +) возвращался - вместо 0 (исправлено вместе с вычислением
+// логических операций над битовыми полями); And = MIN, Or = MAX
+func Test_and_or_min_max(t *testing.T) {
+	for a := int8(-1); a <= 1; a++ {
+		for b := int8(-1); b <= 1; b++ {
+			min, max := a, b
+			if b < a {
+				min, max = b, a
+			}
+			x, y := IntToTrit(a), IntToTrit(b)
+			if r := And(x, y).ToInt(); r != min {
+				t.Errorf("And(%d, %d) = %d, want %d", a, b, r, min)
+			}
+			if r := Or(x, y).ToInt(); r != max {
+				t.Errorf("Or(%d, %d) = %d, want %d", a, b, r, max)
+			}
+		}
+	}
+}
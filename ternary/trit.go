@@ -1,35 +1,18 @@
 /**
- * Filename: 	goTernaryArithmetic.go
+ * Filename: 	trit.go
  *
  * Project:		Троичная арифметика на языке программирования Golang
  *
  * Create date: 28.08.2021
- * Edit date:   13.04.2023
+ * Edit date:   18.10.2026
  *
- * Version:		1.01
+ * Version:		1.02
  *
  */
 
-// Cross Platform Compilation for ARMv7
-// env CC=arm-linux-gnueabi-gcc GOOS=linux GOARCH=arm GOARM=7 CGO_ENABLED=1 go build --ldflags '-linkmode external -extldflags "-static"' .
-//
-
-package main
-
-/*
-#cgo CFLAGS: -g -Wall
-#cgo LDFLAGS: -lm
-#include <stdio.h>
-#include <stdlib.h>
-#include <math.h>
-#include "./trslib.h"
-#include "./trslib.c"
-*/
-import "C"
-
-import (
-	"fmt"
-)
+// Пакет ternary реализует троичную симметричную арифметику и логику:
+// триты (Trit), троичные слова (Word) и операции над ними.
+package ternary
 
 // Таб.1 Алфавит троичной симметричной системы счисления
 // +--------------------------+-------+-------+-------+
@@ -59,34 +42,34 @@ import (
 // ----------------------------------------------------
 
 // Объявление троичных типов
-type trits struct {
+type Trit struct {
 	t uint8 // FALSE,TRUE
 	n uint8 // NIL
 }
 
 // Метод установить трит в True
-func (t trits) SetTrue() (r trits) {
+func (t Trit) SetTrue() (r Trit) {
 	r.t = 1
 	r.n = 1
 	return r
 }
 
 // Метод установить трит в Nil
-func (t trits) SetNil() (r trits) {
+func (t Trit) SetNil() (r Trit) {
 	r.t = 0
 	r.n = 0
 	return r
 }
 
 // Метод установить трит в False
-func (t trits) SetFalse() (r trits) {
+func (t Trit) SetFalse() (r Trit) {
 	r.t = 0
 	r.n = 1
 	return r
 }
 
 // Метод трит в False ?
-func (t trits) IsFalse() bool {
+func (t Trit) IsFalse() bool {
 	if t.n != 0 {
 		if t.t == 0 {
 			return true
@@ -96,7 +79,7 @@ func (t trits) IsFalse() bool {
 }
 
 // Метод трит в Nil ?
-func (t trits) IsNil() bool {
+func (t Trit) IsNil() bool {
 	if t.n == 0 {
 		return true
 	}
@@ -104,7 +87,7 @@ func (t trits) IsNil() bool {
 }
 
 // Метод трит в Nil ?
-func (t trits) IsTrue() bool {
+func (t Trit) IsTrue() bool {
 	if t.n != 0 {
 		if t.t != 0 {
 			return true
@@ -114,14 +97,14 @@ func (t trits) IsTrue() bool {
 }
 
 // Метод очистить трит
-func (t trits) Clear() (r trits) {
+func (t Trit) Clear() (r Trit) {
 	r.t = 0
 	r.n = 0
 	return r
 }
 
 // Метод вернуть символ трита "-1","0","1"
-func (t trits) SymbNumb() string {
+func (t Trit) SymbNumb() string {
 	if t.n == 0 {
 		return "0"
 	} else if t.t != 0 {
@@ -132,7 +115,7 @@ func (t trits) SymbNumb() string {
 }
 
 // Метод вернуть символ трита "%false","%nil","%true"
-func (t trits) SymbLogic() string {
+func (t Trit) SymbLogic() string {
 	if t.n == 0 {
 		return "%nil"
 	} else if t.t != 0 {
@@ -143,7 +126,7 @@ func (t trits) SymbLogic() string {
 }
 
 // Метод вернуть символ трита "-","0","+"
-func (t trits) SymbTrit() string {
+func (t Trit) SymbTrit() string {
 	if t.n == 0 {
 		return "0"
 	} else if t.t != 0 {
@@ -154,7 +137,7 @@ func (t trits) SymbTrit() string {
 }
 
 // Метод вернуть символ трита "M","N","P"
-func (t trits) SymbChar() string {
+func (t Trit) SymbChar() string {
 	if t.n == 0 {
 		return "N"
 	} else if t.t != 0 {
@@ -165,7 +148,7 @@ func (t trits) SymbChar() string {
 }
 
 // Метод вернуть символ трита в int8
-func (t trits) ToInt() int8 {
+func (t Trit) ToInt() int8 {
 	if t.n == 0 {
 		return 0
 	} else if t.t != 0 {
@@ -176,7 +159,7 @@ func (t trits) ToInt() int8 {
 }
 
 // Преобразование целое число в трит
-func int2trit(i int8) (r trits) {
+func IntToTrit(i int8) (r Trit) {
 	if i > 0 {
 		return r.SetTrue()
 	}
@@ -189,8 +172,8 @@ func int2trit(i int8) (r trits) {
 // --------------------------
 // Функции операций с тритами
 
-// Сравнить триты
-func cmp_trits(a trits, b trits) bool {
+// Равны ли триты
+func EqualTrits(a Trit, b Trit) bool {
 	if a == b {
 		return true
 	} else {
@@ -210,7 +193,7 @@ func cmp_trits(a trits, b trits) bool {
 // .------------------------.
 
 // Полусумматор двух тритов с переносом
-func add_half_slowly_t(a trits, b trits) (c trits, carry trits) {
+func AddHalfSlowly(a Trit, b Trit) (c Trit, carry Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return c.SetTrue(), carry.SetFalse()
 	} else if a.IsFalse() && b.IsNil() {
@@ -234,7 +217,7 @@ func add_half_slowly_t(a trits, b trits) (c trits, carry trits) {
 }
 
 // Полусумматор двух тритов с переносом
-func add_half_t(a trits, b trits) (c trits, carry trits) {
+func AddHalf(a Trit, b Trit) (c Trit, carry Trit) {
 	switch a.ToInt() + b.ToInt() {
 	case -2:
 		return c.SetTrue(), carry.SetFalse()
@@ -259,15 +242,15 @@ func add_half_t(a trits, b trits) (c trits, carry trits) {
 // | Перенос в n+1    -1  -1   0   0   1   1 |
 // .-----------------------------------------.
 // Полный сумматор двух тритов с переносом
-func add_full_slowly_t(a trits, b trits, incarry trits) (c trits, outcarry trits) {
-	s, sc := add_half_slowly_t(a, b)
-	d, dc := add_half_slowly_t(s, incarry)
-	ss, _ := add_half_slowly_t(sc, dc)
+func AddFullSlowly(a Trit, b Trit, incarry Trit) (c Trit, outcarry Trit) {
+	s, sc := AddHalfSlowly(a, b)
+	d, dc := AddHalfSlowly(s, incarry)
+	ss, _ := AddHalfSlowly(sc, dc)
 	return d, ss
 }
 
 // Полный сумматор двух тритов с переносом
-func add_full_t(a trits, b trits, incarry trits) (c trits, outcarry trits) {
+func AddFull(a Trit, b Trit, incarry Trit) (c Trit, outcarry Trit) {
 
 	switch a.ToInt() + b.ToInt() + incarry.ToInt() {
 	case -3:
@@ -289,7 +272,9 @@ func add_full_t(a trits, b trits, incarry trits) (c trits, outcarry trits) {
 }
 
 // Таб.4 Троичное умножение
-//       MUL
+//
+//	MUL
+//
 // .-----------------------.
 // |     | -1  |  0  |  1  |
 // |-----------------------|
@@ -300,10 +285,10 @@ func add_full_t(a trits, b trits, incarry trits) (c trits, outcarry trits) {
 // |  1  |  -  |  0  |  +  |
 // .-----------------------.
 // Троичное умножение двух тритов с переносом
-func mul_slowly_t(a trits, b trits) (r trits) {
+func MulSlowly(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetTrue()
-	} else if a.IsFalse() && b.IsFalse() {
+	} else if a.IsFalse() && b.IsTrue() {
 		return r.SetFalse()
 	} else if a.IsTrue() && b.IsFalse() {
 		return r.SetFalse()
@@ -323,7 +308,7 @@ func mul_slowly_t(a trits, b trits) (r trits) {
 // |  1  |  -  |  0  |  +  |
 // .-----------------------.
 // Троичное умножение двух тритов с переносом
-func mul_t(a trits, b trits) (r trits) {
+func Mul(a Trit, b Trit) (r Trit) {
 	switch a.ToInt() * b.ToInt() {
 	case 1:
 		return r.SetTrue()
@@ -336,7 +321,9 @@ func mul_t(a trits, b trits) (r trits) {
 }
 
 // Таб.5 Троичное отрицание
-//       NOT
+//
+//	NOT
+//
 // .-----------.
 // |  -  |  +  |
 // |-----------|
@@ -344,7 +331,7 @@ func mul_t(a trits, b trits) (r trits) {
 // |-----------|
 // |  +  |  -  |
 // .-----------.
-func not_t(a trits) (r trits) {
+func Not(a Trit) (r Trit) {
 	if a.IsFalse() {
 		return r.SetTrue()
 	} else if a.IsTrue() {
@@ -354,7 +341,9 @@ func not_t(a trits) (r trits) {
 }
 
 // Таб.6 Троичное умножение
-//       AND
+//
+//	AND
+//
 // .-----------------------.
 // |     | -1  |  0  |  1  |
 // |-----------------------|
@@ -364,9 +353,11 @@ func not_t(a trits) (r trits) {
 // |-----------------------|
 // |  1  |  -  |  0  |  +  |
 // .-----------------------.
-//  X AND Y = MIN(X,Y)
+//
+//	X AND Y = MIN(X,Y)
+//
 // Троичное умножение двух тритов с переносом
-func and_t(a trits, b trits) (r trits) {
+func And(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetFalse()
 	} else if a.IsFalse() && b.IsNil() {
@@ -378,7 +369,7 @@ func and_t(a trits, b trits) (r trits) {
 	} else if a.IsNil() && b.IsNil() {
 		return r.SetNil()
 	} else if a.IsNil() && b.IsTrue() {
-		return r.SetFalse()
+		return r.SetNil()
 	} else if a.IsTrue() && b.IsFalse() {
 		return r.SetFalse()
 	} else if a.IsTrue() && b.IsNil() {
@@ -390,7 +381,9 @@ func and_t(a trits, b trits) (r trits) {
 }
 
 // Таб.7 Троичное или
-//       OR
+//
+//	OR
+//
 // .-----------------------.
 // |     | -1  |  0  |  1  |
 // |-----------------------|
@@ -400,8 +393,9 @@ func and_t(a trits, b trits) (r trits) {
 // |-----------------------|
 // |  1  |  +  |  +  |  +  |
 // .-----------------------.
-//   X OR Y = MAX(X,Y)
-func or_t(a trits, b trits) (r trits) {
+//
+//	X OR Y = MAX(X,Y)
+func Or(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetFalse()
 	} else if a.IsFalse() && b.IsNil() {
@@ -425,7 +419,9 @@ func or_t(a trits, b trits) (r trits) {
 }
 
 // Таб.8 Троичное исключающее или
-//       XOR
+//
+//	XOR
+//
 // .-----------------------.
 // |     | -1  |  0  |  1  |
 // |-----------------------|
@@ -435,7 +431,7 @@ func or_t(a trits, b trits) (r trits) {
 // |-----------------------|
 // |  1  |  +  |  0  |  -  |
 // .-----------------------.
-func xor_t(a trits, b trits) (r trits) {
+func Xor(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetFalse()
 	} else if a.IsFalse() && b.IsNil() {
@@ -459,7 +455,9 @@ func xor_t(a trits, b trits) (r trits) {
 }
 
 // Таб.9 Троичное
-//       EQV
+//
+//	EQV
+//
 // .-----------------------.
 // |     | -1  |  0  |  1  |
 // |-----------------------|
@@ -470,7 +468,7 @@ func xor_t(a trits, b trits) (r trits) {
 // |  1  |  -  |  0  |  +  |
 // .-----------------------.
 // EQV(X,Y) = NOT (XOR(X,Y))
-func eqv_t(a trits, b trits) (r trits) {
+func Eqv(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetTrue()
 	} else if a.IsFalse() && b.IsNil() {
@@ -494,7 +492,9 @@ func eqv_t(a trits, b trits) (r trits) {
 }
 
 // Таб.10 Троичное
-//       NAND
+//
+//	NAND
+//
 // .-----------------------.
 // |     | -1  |  0  |  1  |
 // |-----------------------|
@@ -505,7 +505,7 @@ func eqv_t(a trits, b trits) (r trits) {
 // |  1  |  +  |  0  |  -  |
 // .-----------------------.
 // NAND(X,Y) = NOT (AND(X,Y))
-func nand_t(a trits, b trits) (r trits) {
+func Nand(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetTrue()
 	} else if a.IsFalse() && b.IsNil() {
@@ -529,7 +529,9 @@ func nand_t(a trits, b trits) (r trits) {
 }
 
 // Таб.11 Троичное
-//       NOR
+//
+//	NOR
+//
 // .-----------------------.
 // |     | -1  |  0  |  1  |
 // |-----------------------|
@@ -540,7 +542,7 @@ func nand_t(a trits, b trits) (r trits) {
 // |  1  |  -  |  -  |  -  |
 // .-----------------------.
 // NOR(X,Y) = NOT((OR(X,Y))
-func nor_t(a trits, b trits) (r trits) {
+func Nor(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetTrue()
 	} else if a.IsFalse() && b.IsNil() {
@@ -564,7 +566,9 @@ func nor_t(a trits, b trits) (r trits) {
 }
 
 // Таб.12 Троичное
-//       IMP
+//
+//	IMP
+//
 // .-----------------------.
 // |     | -1  |  0  |  1  |
 // |-----------------------|
@@ -574,7 +578,7 @@ func nor_t(a trits, b trits) (r trits) {
 // |-----------------------|
 // |  1  |  -  |  0  |  +  |
 // .-----------------------.
-func imp_t(a trits, b trits) (r trits) {
+func Imp(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetTrue()
 	} else if a.IsFalse() && b.IsNil() {
@@ -598,7 +602,9 @@ func imp_t(a trits, b trits) (r trits) {
 }
 
 // Таб.13 Троичное исключающее максимального
-//        XMAX
+//
+//	XMAX
+//
 // .-----------------------.
 // |     | -1  |  0  |  1  |
 // |-----------------------|
@@ -609,10 +615,11 @@ func imp_t(a trits, b trits) (r trits) {
 // |  1  |  +  |  +  |  -  |
 // .-----------------------.
 // XMAX:
+//
 //	F = MAX(A,B), если A != B
 //	    0	    , если A == B
 //	(имейте в виду - это не XOR).
-func xmax_t(a trits, b trits) (r trits) {
+func Xmax(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetFalse()
 	} else if a.IsFalse() && b.IsNil() {
@@ -636,7 +643,9 @@ func xmax_t(a trits, b trits) (r trits) {
 }
 
 // Таб.14 Троичное Инверсно Исключающий минимального
-//        IXMAX
+//
+//	IXMAX
+//
 // .-----------------------.
 // |     | -1  |  0  |  1  |
 // |-----------------------|
@@ -646,7 +655,7 @@ func xmax_t(a trits, b trits) (r trits) {
 // |-----------------------|
 // |  1  |  -  |  0  |  +  |
 // .-----------------------.
-func ixmax_t(a trits, b trits) (r trits) {
+func Ixmax(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetTrue()
 	} else if a.IsFalse() && b.IsNil() {
@@ -670,7 +679,9 @@ func ixmax_t(a trits, b trits) (r trits) {
 }
 
 // Таб.15 Троичное Инверсно Исключающий минимального
-//        MEAN
+//
+//	MEAN
+//
 // .-----------------------.
 // |     | -1  |  0  |  1  |
 // |-----------------------|
@@ -681,11 +692,12 @@ func ixmax_t(a trits, b trits) (r trits) {
 // |  1  |  -  |  0  |  -  |
 // .-----------------------.
 // Mean:
+//
 //	Смотрит насколько "средние" операнды
 //	Если ii, то возращает 1
 //	Если iX или Xi, то возращает i
 //	Иначе 0
-func mean_t(a trits, b trits) (r trits) {
+func Mean(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetFalse()
 	} else if a.IsFalse() && b.IsNil() {
@@ -709,7 +721,9 @@ func mean_t(a trits, b trits) (r trits) {
 }
 
 // Таб.16 Троичное Инверсно Исключающий минимального
-//        Magnitude
+//
+//	Magnitude
+//
 // .-----------------------.
 // |     | -1  |  0  |  1  |
 // |-----------------------|
@@ -720,12 +734,13 @@ func mean_t(a trits, b trits) (r trits) {
 // |  1  |  +  |  +  |  0  |
 // .-----------------------.
 // Magnitude:
+//
 //	Сравнение
 //	(Функция нессимитричная)
 //	Возращает 0, если A < B
 //		  i, если A = B
 //		  1, если A > B
-func magnitude_t(a trits, b trits) (r trits) {
+func Magnitude(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetNil()
 	} else if a.IsFalse() && b.IsNil() {
@@ -749,7 +764,9 @@ func magnitude_t(a trits, b trits) (r trits) {
 }
 
 // Таб.17 Троичное дополнительный код
-//        NEG
+//
+//	NEG
+//
 // .-----------.
 // |  -  |  -  |
 // |-----------|
@@ -757,7 +774,7 @@ func magnitude_t(a trits, b trits) (r trits) {
 // |-----------|
 // |  +  |  0  |
 // .-----------.
-func neg_t(a trits) (r trits) {
+func Neg(a Trit) (r Trit) {
 	if a.IsFalse() {
 		return r.SetFalse()
 	} else if a.IsNil() {
@@ -769,15 +786,15 @@ func neg_t(a trits) (r trits) {
 // Расмотрим еще некоторые функции
 // { -, 0, + }
 //
-//  Сложение по модулю
-//	--+----------
-//	  | -  0  +
-//	--+----------
-//	- | +  -  0
-//	0 | -  0  +
-//	+ | 0  +  -
-//	--+----------
-func add_mod_t(a trits, b trits) (r trits) {
+//	 Сложение по модулю
+//		--+----------
+//		  | -  0  +
+//		--+----------
+//		- | +  -  0
+//		0 | -  0  +
+//		+ | 0  +  -
+//		--+----------
+func AddMod(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetTrue()
 	} else if a.IsFalse() && b.IsNil() {
@@ -800,15 +817,15 @@ func add_mod_t(a trits, b trits) (r trits) {
 	return r.SetNil()
 }
 
-//  Перенос в сложении по модулю
-//	--+----------
-//	  | -  0  +
-//	--+----------
-//	- | -  0  0
-//	0 | 0  0  0
-//	+ | 0  0  +
-//	--+----------
-func carry_add_mod_t(a trits, b trits) (r trits) {
+//	 Перенос в сложении по модулю
+//		--+----------
+//		  | -  0  +
+//		--+----------
+//		- | -  0  0
+//		0 | 0  0  0
+//		+ | 0  0  +
+//		--+----------
+func CarryAddMod(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetFalse()
 	} else if a.IsFalse() && b.IsNil() {
@@ -831,15 +848,15 @@ func carry_add_mod_t(a trits, b trits) (r trits) {
 	return r.SetNil()
 }
 
-//  Сложение с насышением
-//	--+----------
-//	  | -  0  +
-//	--+----------
-//	- | -  -  0
-//	0 | -  0  +
-//	+ | 0  +  +
-//	--+----------
-func add_satiation_t(a trits, b trits) (r trits) {
+//	 Сложение с насышением
+//		--+----------
+//		  | -  0  +
+//		--+----------
+//		- | -  -  0
+//		0 | -  0  +
+//		+ | 0  +  +
+//		--+----------
+func AddSatiation(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetFalse()
 	} else if a.IsFalse() && b.IsNil() {
@@ -862,16 +879,15 @@ func add_satiation_t(a trits, b trits) (r trits) {
 	return r.SetNil()
 }
 
-//
-//  Функция Webb
-//	--+----------
-//	  | -  0  +
-//	--+----------
-//	- | 0  +  -
-//	0 | +  +  -
-//	+ | -  -  -
-//	--+----------
-func webb_t(a trits, b trits) (r trits) {
+//	 Функция Webb
+//		--+----------
+//		  | -  0  +
+//		--+----------
+//		- | 0  +  -
+//		0 | +  +  -
+//		+ | -  -  -
+//		--+----------
+func Webb(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetNil()
 	} else if a.IsFalse() && b.IsNil() {
@@ -894,15 +910,15 @@ func webb_t(a trits, b trits) (r trits) {
 	return r.SetNil()
 }
 
-//   Тождество (строгоe)
-//	--+----------
-//	  | -  0  +
-//	--+----------
-//	- | +  -  -
-//	0 | -  +  -
-//	+ | -  -  +
-//	--+----------
-func identity_strict_t(a trits, b trits) (r trits) {
+//	  Тождество (строгоe)
+//		--+----------
+//		  | -  0  +
+//		--+----------
+//		- | +  -  -
+//		0 | -  +  -
+//		+ | -  -  +
+//		--+----------
+func IdentityStrict(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetTrue()
 	} else if a.IsFalse() && b.IsNil() {
@@ -925,15 +941,15 @@ func identity_strict_t(a trits, b trits) (r trits) {
 	return r.SetNil()
 }
 
-//   Тождество (weak)
-//	--+----------
-//	  | -  0  +
-//	--+----------
-//	- | +  0  -
-//	0 | 0  0  0      в общем как умножение
-//	+ | -  0  +
-//	--+----------
-func weak_t(a trits, b trits) (r trits) {
+//	  Тождество (weak)
+//		--+----------
+//		  | -  0  +
+//		--+----------
+//		- | +  0  -
+//		0 | 0  0  0      в общем как умножение
+//		+ | -  0  +
+//		--+----------
+func Weak(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetTrue()
 	} else if a.IsFalse() && b.IsNil() {
@@ -956,15 +972,15 @@ func weak_t(a trits, b trits) (r trits) {
 	return r.SetNil()
 }
 
-//  Коньюнкция Лукашевича (сильная)
-//	--+----------
-//	  | -  0  +
-//	--+----------
-//	- | -  -  -
-//	0 | -  -  0
-//	+ | -  0  +
-//	--+----------
-func conjunction_lukashevich_strong_t(a trits, b trits) (r trits) {
+//	 Коньюнкция Лукашевича (сильная)
+//		--+----------
+//		  | -  0  +
+//		--+----------
+//		- | -  -  -
+//		0 | -  -  0
+//		+ | -  0  +
+//		--+----------
+func ConjunctionLukashevichStrong(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetFalse()
 	} else if a.IsFalse() && b.IsNil() {
@@ -987,15 +1003,15 @@ func conjunction_lukashevich_strong_t(a trits, b trits) (r trits) {
 	return r.SetNil()
 }
 
-//  Импликация Лукашевича
-//	--+----------
-//	  | -  0  +
-//	--+----------
-//	- | +  0  -
-//	0 | +  +  0
-//	+ | +  +  +
-//	--+----------
-func lukashevich_implication_t(a trits, b trits) (r trits) {
+//	 Импликация Лукашевича
+//		--+----------
+//		  | -  0  +
+//		--+----------
+//		- | +  0  -
+//		0 | +  +  0
+//		+ | +  +  +
+//		--+----------
+func LukashevichImplication(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetTrue()
 	} else if a.IsFalse() && b.IsNil() {
@@ -1018,15 +1034,15 @@ func lukashevich_implication_t(a trits, b trits) (r trits) {
 	return r.SetNil()
 }
 
-//  Коньюнкция Клини
-//	--+----------
-//	  | -  0  +
-//	--+----------
-//	- | -  0  -
-//	0 | 0  0  0
-//	+ | -  0  +
-//	--+----------
-func klini_conjunction_t(a trits, b trits) (r trits) {
+//	 Коньюнкция Клини
+//		--+----------
+//		  | -  0  +
+//		--+----------
+//		- | -  0  -
+//		0 | 0  0  0
+//		+ | -  0  +
+//		--+----------
+func KleeneConjunction(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetFalse()
 	} else if a.IsFalse() && b.IsNil() {
@@ -1049,15 +1065,15 @@ func klini_conjunction_t(a trits, b trits) (r trits) {
 	return r.SetNil()
 }
 
-//  Импликация Клини
-//	--+----------
-//	  | -  0  +
-//	--+----------
-//	- | +  +  +
-//	0 | 0  0  0
-//	+ | -  0  +
-//	--+----------
-func implication_clinic_t(a trits, b trits) (r trits) {
+//	 Импликация Клини
+//		--+----------
+//		  | -  0  +
+//		--+----------
+//		- | +  +  +
+//		0 | 0  0  0
+//		+ | -  0  +
+//		--+----------
+func KleeneImplication(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetTrue()
 	} else if a.IsFalse() && b.IsNil() {
@@ -1080,15 +1096,15 @@ func implication_clinic_t(a trits, b trits) (r trits) {
 	return r.SetNil()
 }
 
-//  Интуиционистская импликация Геделя
-//	--+----------
-//	  | -  0  +
-//	--+----------
-//	- | +  -  -
-//	0 | +  +  0
-//	+ | +  +  +
-//	--+----------
-func goedel_intuitionistic_implication_t(a trits, b trits) (r trits) {
+//	 Интуиционистская импликация Геделя
+//		--+----------
+//		  | -  0  +
+//		--+----------
+//		- | +  -  -
+//		0 | +  +  0
+//		+ | +  +  +
+//		--+----------
+func GoedelImplication(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetTrue()
 	} else if a.IsFalse() && b.IsNil() {
@@ -1111,15 +1127,15 @@ func goedel_intuitionistic_implication_t(a trits, b trits) (r trits) {
 	return r.SetNil()
 }
 
-//  Материальная импликация
-//	--+----------
-//	  | -  0  +
-//	--+----------
-//	- | +  0  -
-//	0 | +  0  0
-//	+ | +  +  +
-//	--+----------
-func material_implication_t(a trits, b trits) (r trits) {
+//	 Материальная импликация
+//		--+----------
+//		  | -  0  +
+//		--+----------
+//		- | +  0  -
+//		0 | +  0  0
+//		+ | +  +  +
+//		--+----------
+func MaterialImplication(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetTrue()
 	} else if a.IsFalse() && b.IsNil() {
@@ -1142,15 +1158,15 @@ func material_implication_t(a trits, b trits) (r trits) {
 	return r.SetNil()
 }
 
-//  Функция следования Брусенцова
-//	--+----------
-//	  | -  0  +
-//	--+----------
-//	- | +  0  -
-//	0 | 0  0  0
-//	+ | 0  0  +
-//	--+----------
-func following_brusentsov_t(a trits, b trits) (r trits) {
+//	 Функция следования Брусенцова
+//		--+----------
+//		  | -  0  +
+//		--+----------
+//		- | +  0  -
+//		0 | 0  0  0
+//		+ | 0  0  +
+//		--+----------
+func FollowingBrusentsov(a Trit, b Trit) (r Trit) {
 	if a.IsFalse() && b.IsFalse() {
 		return r.SetTrue()
 	} else if a.IsFalse() && b.IsNil() {
@@ -1173,9 +1189,9 @@ func following_brusentsov_t(a trits, b trits) (r trits) {
 	return r.SetNil()
 }
 
-// Троичное сложение двух тритов с переносом
+// Троичное сложение двух тритов с переносом, a, b, p0 = -1, 0, 1
 // (Для измерения производительности операций int)
-func sum_t(a int8, b int8, p0 int8) (int8, int8) {
+func sumT(a int8, b int8, p0 int8) (int8, int8) {
 
 	if a > 0 {
 		a = 1
@@ -1186,37 +1202,25 @@ func sum_t(a int8, b int8, p0 int8) (int8, int8) {
 	s := a + b + p0
 	switch s {
 	case -3:
-		return 0, 1
-		break
+		return 0, -1
 	case -2:
 		return 1, -1
-		break
 	case -1:
 		return -1, 0
-		break
 	case 0:
 		return 0, 0
-		break
 	case 1:
 		return 1, 0
-		break
 	case 2:
 		return -1, 1
-		break
 	case 3:
 		return 0, 1
-		break
-	default:
-		return 0, 0
-		break
 	}
 	return 0, 0
 }
 
-//
 // Реализация чтение трита
-//
-func get_trit(t1 uint32, t0 uint32, p uint8) int {
+func getTrit(t1 uint32, t0 uint32, p uint8) int {
 	var trit int
 	if (t0 & (1 << p)) == 0 {
 		trit = 0
@@ -1229,741 +1233,3 @@ func get_trit(t1 uint32, t0 uint32, p uint8) int {
 	}
 	return trit
 }
-
-// ----------------------------------------------------
-// 32-TRITS
-// ----------------------------------------------------
-const TRITSMAX = 32
-
-// Троичный тип данных
-type trs struct {
-	l  uint8  // длина троичного числа в тритах
-	t1 uint32 // двоичное битовое поле троичного числа {FALSE,TRUE}
-	t0 uint32 // двоичное битовое поле троичного числа {NIL}
-	// if t0[i] == 0 then trit[i] = NIL emdif
-	// if (t0[i] == 1) && (t1[i] == 0)  then trit[i] = FALSE emdif //
-	// if (t0[i] == 1) && (t1[i] == 1)  then trit[i] = TRUE emdif //
-}
-
-// Метод получить трит в позиции троичного числа
-func (ts trs) GetTrit(p uint8) int8 {
-	if p > TRITSMAX {
-		return 0
-	}
-
-	if (ts.t0 & (1 << p)) == 0 {
-		return 0
-	} else if (ts.t1 & (1 << p)) != 0 {
-		return 1
-	} else {
-		return 0
-	}
-	return 0
-}
-
-// Метод получить трит в позиции троичного числа
-func (ts trs) SetTrit(p uint8, t int8) (r trs) {
-	if p > TRITSMAX {
-		return ts
-	}
-
-	if t > 0 {
-		ts.t1 |= (1 << p)
-		ts.t0 |= (1 << p)
-	}
-	if t < 0 {
-		ts.t1 &^= (1 << p)
-		ts.t0 |= (1 << p)
-	}
-	ts.t1 &^= (1 << p)
-	ts.t0 &^= (1 << p)
-
-	return ts
-}
-
-// Метод установить трит в True
-func (ts trs) SetTrue(p uint8) trs {
-	if p > TRITSMAX {
-		p = TRITSMAX
-	}
-	ts.t1 |= (1 << p)
-	ts.t0 |= (1 << p)
-	return ts
-}
-
-// Метод установить трит в Nil
-func (ts trs) SetNil(p uint8) trs {
-	if p > TRITSMAX {
-		p = TRITSMAX
-	}
-	ts.t1 &^= (1 << p)
-	ts.t0 &^= (1 << p)
-	return ts
-}
-
-// Метод установить трит в False
-func (ts trs) SetFalse(p uint8) trs {
-	if p > TRITSMAX {
-		p = TRITSMAX
-	}
-	ts.t1 &^= (1 << p)
-	ts.t0 |= (1 << p)
-	return ts
-}
-
-// Метод трит в False ?
-func (ts trs) IsFalse(p uint8) bool {
-
-	if (ts.t0 & (1 << p)) == 0 {
-		return false
-	}
-	if (ts.t1 & (1 << p)) == 0 {
-		return true
-	} else {
-		return false
-	}
-}
-
-// Метод трит в Nil ?
-func (ts trs) IsNil(p uint8) bool {
-	if (ts.t0 & (1 << p)) == 0 {
-		return true
-	}
-	if (ts.t1 & (1 << p)) == 0 {
-		return false
-	} else {
-		return false
-	}
-}
-
-// Метод трит в Nil ?
-func (ts trs) IsTrue(p uint8) bool {
-	if (ts.t0 & (1 << p)) == 0 {
-		return false
-	}
-	if (ts.t1 & (1 << p)) == 0 {
-		return false
-	} else {
-		return true
-	}
-}
-
-// Метод очистить трит
-func (ts trs) Clear(p uint8) trs {
-	ts.t1 &^= (1 << p)
-	ts.t0 &^= (1 << p)
-	return ts
-}
-
-// Метод вернуть символ трита "-1","0","1"
-//func (t trits) SymbNumb(p int8) string {
-//	if t.n == 0 {
-//		return "0"
-//	} else if t.t != 0 {
-//		return "1"
-//	} else {
-//		return "-1"
-//	}
-//}
-
-// Метод вернуть символ трита "%false","%nil","%true"
-//func (t trits) SymbLogic(p int8) string {
-//	if t.n == 0 {
-//		return "%nil"
-//	} else if t.t != 0 {
-//		return "%true"
-//	} else {
-//		return "%false"
-//	}
-//}
-
-// Метод вернуть символ трита "-","0","+"
-//func (t trits) SymbTrit(p int8) string {
-//	if t.n == 0 {
-//		return "0"
-//	} else if t.t != 0 {
-//		return "+"
-//	} else {
-//		return "-"
-//	}
-//}
-
-// Метод вернуть символ трита "M","N","P"
-//func (t trits) SymbChar(p int8) string {
-//	if t.n == 0 {
-//		return "N"
-//	} else if t.t != 0 {
-//		return "P"
-//	} else {
-//		return "M"
-//	}
-//}
-
-// Метод вернуть символ трита в int8
-//func (t trits) ToInt(p int8) int8 {
-//	if t.n == 0 {
-//		return 0
-//	} else if t.t != 0 {
-//		return 1
-//	} else {
-//		return -1
-//	}
-//}
-
-//
-// Операция сдвига тритов
-// Версия 1
-// Параметр:
-// if(d > 0) then "Вправо"
-// if(d == 0) then "Нет сдвига"
-// if(d < 0) then "Влево"
-// Возврат: Троичное число
-//
-func shift_ts(tr trs, d int8) trs {
-	if d > 0 {
-		tr.t1 >>= d
-		tr.t0 >>= d
-	} else if d < 0 {
-		tr.t1 <<= -d
-		tr.t0 <<= -d
-	}
-	return tr
-}
-
-// Изменить порядок тритов в трайте
-//func reverseTryte(input []interface{}) []interface{} {
-//	if len(input) == 0 {
-//		return input
-//	}
-//	return append(reverseTryte(input[1:]), input[0])
-//}
-
-// Изменить порядок тритов в трайте
-//func printTryteInt(input []interface{}) []interface{} {
-//	if len(input) == 0 {
-//		return input
-//	}
-//	return append(printTryteInt(input[1:]), trit2int(input[0]))
-//}
-
-// Изменить порядок тритов в трайте
-//func printTryteSymb(input []interface{}) []interface{} {
-//	if len(input) == 0 {
-//		return input
-//	}
-//	return append(printTryteSymb(input[1:]), trit2symb(input[0]))
-//}
-
-// ***************************************************************************
-// Виртуальный процессор: TRISC-32
-// Автор: @oberon87
-//
-// Links:
-// 1) https://habr.com/ru/users/oberon87/
-// 2) https://people.inf.ethz.ch/wirth/FPGA-relatedWork/RISC-Arch.pdf
-// 3) https://people.inf.ethz.ch/wirth/ProjectOberon/PO.Computer.pdf
-// 4) https://habr.com/ru/post/258727/
-// ---------------------------------------------------------------------------
-
-// Основные регистры в порядке пульта управления
-var (
-	K  trs // K(1:9)  код команды (адрес ячейки оперативной памяти)
-	F  trs // F(1:5)  индекс регистр
-	CR trs // C(1:5)  программный счетчик
-	W  trs // W(1:1)  знак троичного числа
-	//
-	ph1 trs // ph1(1:1) 1 разряд переполнения
-	ph2 trs // ph2(1:1) 2 разряд переполнения
-	S   trs // S(1:18) аккумулятор
-	R   trs // R(1:18) регистр множителя
-	MB  trs // MB(1:4) троичное число зоны магнитного барабана
-	// Дополнительный
-	MR trs // временный регистр для обмена троичным числом
-)
-
-// Троичное умножение двух тритов с переносом
-func and_trit(a trits, b trits) int8 {
-	if a.IsFalse() && b.IsFalse() {
-		return -1
-	} else if a.IsFalse() && b.IsNil() {
-		return -1
-	} else if a.IsFalse() && b.IsTrue() {
-		return -1
-	} else if a.IsNil() && b.IsFalse() {
-		return -1
-	} else if a.IsNil() && b.IsNil() {
-		return 0
-	} else if a.IsNil() && b.IsTrue() {
-		return -1
-	} else if a.IsTrue() && b.IsFalse() {
-		return -1
-	} else if a.IsTrue() && b.IsNil() {
-		return 0
-	} else if a.IsTrue() && b.IsTrue() {
-		return 1
-	}
-	return 0
-}
-
-func or_trit(a trits, b trits) int8 {
-	if a.IsFalse() && b.IsFalse() {
-		return -1
-	} else if a.IsFalse() && b.IsNil() {
-		return 0
-	} else if a.IsFalse() && b.IsTrue() {
-		return 1
-	} else if a.IsNil() && b.IsFalse() {
-		return 0
-	} else if a.IsNil() && b.IsNil() {
-		return 0
-	} else if a.IsNil() && b.IsTrue() {
-		return 1
-	} else if a.IsTrue() && b.IsFalse() {
-		return 1
-	} else if a.IsTrue() && b.IsNil() {
-		return 1
-	} else if a.IsTrue() && b.IsTrue() {
-		return 1
-	}
-	return 0
-}
-
-func xor_trit(a trits, b trits) int8 {
-	if a.IsFalse() && b.IsFalse() {
-		return -1
-	} else if a.IsFalse() && b.IsNil() {
-		return 0
-	} else if a.IsFalse() && b.IsTrue() {
-		return 1
-	} else if a.IsNil() && b.IsFalse() {
-		return 0
-	} else if a.IsNil() && b.IsNil() {
-		return 0
-	} else if a.IsNil() && b.IsTrue() {
-		return 0
-	} else if a.IsTrue() && b.IsFalse() {
-		return 1
-	} else if a.IsTrue() && b.IsNil() {
-		return 0
-	} else if a.IsTrue() && b.IsTrue() {
-		return -1
-	}
-	return 0
-}
-
-// Очистить троичное число и длину
-func clear_full_trs(tr *trs) {
-	tr.l = 0
-	tr.t0 ^= tr.t0
-}
-
-// Очистить троичное число
-func clear_trs(tr *trs) {
-	tr.t0 ^= tr.t0
-}
-
-// Возведение в степень по модулю 3
-func pow3(x int8) int32 {
-	var i int8
-	var r int32 = 1
-	for i = 0; i < x; i++ {
-		r *= 3
-	}
-	return r
-}
-
-// Преобразование трита в целое число
-func trs2int(tr trs, p uint8) int8 {
-	if p > TRITSMAX-1 {
-		p = TRITSMAX - 1
-	}
-	if (tr.t0 & (1 << p)) == 0 {
-		return 0
-	} else {
-		if (tr.t1 & (1 << p)) == 0 {
-			return -1
-		} else {
-			return 1
-		}
-	}
-}
-
-// Преобразование целое число в трит
-func int2trs(tr trs, p uint8, i int8) trs {
-	if p > TRITSMAX-1 {
-		p = TRITSMAX - 1
-	}
-	if i > 0 {
-		tr.t1 |= (1 << p)
-		tr.t0 |= (1 << p)
-		return tr
-	}
-	if i < 0 {
-		tr.t1 &^= (1 << p)
-		tr.t0 |= (1 << p)
-		return tr
-	}
-	tr.t1 &^= (1 << p)
-	tr.t0 &^= (1 << p)
-	return tr
-}
-
-//  Преобразовать трит в символ '-','0','+'
-func trs2symb(tr trs, p uint8, i int8) string {
-	if p > TRITSMAX-1 {
-		p = TRITSMAX - 1
-	}
-	if tr.t0 == 0 {
-		return "0"
-	} else {
-		if tr.t1 == 0 {
-			return "-"
-		} else {
-			return "+"
-		}
-	}
-}
-
-// Операция знак SGN троичного числа
-func sgn_trs(x trs) int8 {
-	var i int8
-	if x.l > TRITSMAX-1 {
-		x.l = TRITSMAX - 1
-	}
-	for i = int8(x.l) - 1; i >= 0; i -= 1 {
-		if ((x.t0 & (1 << i)) > 0) && ((x.t1 & (1 << i)) == 0) {
-			return -1
-		} else if ((x.t0 & (1 << i)) > 0) && ((x.t1 & (1 << i)) > 0) {
-			return 1
-		}
-	}
-	return 0
-}
-
-// Операция AND trs
-func and_trs(x trs, y trs) trs {
-
-	var r trs
-	var i, j uint8
-	var a, b, s int8
-
-	if x.l > TRITSMAX-1 {
-		x.l = TRITSMAX - 1
-	}
-	if y.l > TRITSMAX-1 {
-		y.l = TRITSMAX - 1
-	}
-
-	if x.l >= y.l {
-		j = x.l
-	} else {
-		j = y.l
-	}
-
-	for i = 0; i < j; i++ {
-		a = trs2int(x, i)
-		b = trs2int(y, i)
-		s = and_trit(int2trit(a), int2trit(b))
-		r = int2trs(r, i, s)
-	}
-
-	return r
-}
-
-// Операция OR trs
-func or_trs(x trs, y trs) trs {
-
-	var r trs
-	var i, j uint8
-	var a, b, s int8
-
-	if x.l > TRITSMAX-1 {
-		x.l = TRITSMAX - 1
-	}
-	if y.l > TRITSMAX-1 {
-		y.l = TRITSMAX - 1
-	}
-
-	if x.l >= y.l {
-		j = x.l
-	} else {
-		j = y.l
-	}
-
-	for i = 0; i < j; i++ {
-		a = trs2int(x, i)
-		b = trs2int(y, i)
-		s = or_trit(int2trit(a), int2trit(b))
-		r = int2trs(r, i, s)
-	}
-
-	return r
-}
-
-// Операция XOR trs
-func xor_trs(x trs, y trs) trs {
-
-	var r trs
-	var i, j uint8
-	var a, b, s int8
-
-	if x.l > TRITSMAX-1 {
-		x.l = TRITSMAX - 1
-	}
-	if y.l > TRITSMAX-1 {
-		y.l = TRITSMAX - 1
-	}
-
-	if x.l >= y.l {
-		j = x.l
-	} else {
-		j = y.l
-	}
-
-	for i = 0; i < j; i++ {
-		a = trs2int(x, i)
-		b = trs2int(y, i)
-		s = xor_trit(int2trit(a), int2trit(b))
-		r = int2trs(r, i, s)
-	}
-
-	return r
-}
-
-/**
- * Операция сдвига тритов
- * Параметр:
- * if(d > 0) then "Вправо"
- * if(d == 0) then "Нет сдвига"
- * if(d < 0) then "Влево"
- * Возврат: Троичное число
- */
-func shift_trs(tr trs, d int8) trs {
-	if tr.l > TRITSMAX-1 {
-		tr.l = TRITSMAX - 1
-	}
-	if d > 0 {
-		tr.t1 >>= d
-		tr.t0 >>= d
-	} else if d < 0 {
-		tr.t1 <<= -d
-		tr.t0 <<= -d
-	}
-	return tr
-}
-
-// Троичное сложение троичных чисел
-func add_trs(x trs, y trs) trs {
-	var i, j uint8
-	var a, b, s, p0, p1 int8
-	var r trs
-
-	if x.l > TRITSMAX-1 {
-		x.l = TRITSMAX - 1
-	}
-	if y.l > TRITSMAX-1 {
-		y.l = TRITSMAX - 1
-	}
-	if x.l >= y.l {
-		j = x.l
-	} else {
-		j = y.l
-	}
-
-	r.l = j
-	r.t0 = 0
-
-	p0 = 0
-	p1 = 0
-
-	for i = 0; i < j; i++ {
-
-		a = trs2int(x, i)
-		b = trs2int(y, i)
-		s, p1 = sum_t(a, b, p0)
-		r = int2trs(r, i, s)
-		p0 = p1
-
-		x.t1 >>= 1
-		x.t0 >>= 1
-		y.t1 >>= 1
-		y.t0 >>= 1
-	}
-	return r
-}
-
-// Троичное сложение троичных чисел
-func sub_trs(x trs, y trs) trs {
-	var i, j uint8
-	var a, b, s, p0, p1 int8
-	var r trs
-
-	if x.l > TRITSMAX-1 {
-		x.l = TRITSMAX - 1
-	}
-	if y.l > TRITSMAX-1 {
-		y.l = TRITSMAX - 1
-	}
-	if x.l >= y.l {
-		j = x.l
-	} else {
-		j = y.l
-	}
-
-	r.l = j
-	r.t0 = 0
-
-	p0 = 0
-	p1 = 0
-
-	for i = 0; i < j; i++ {
-
-		a = trs2int(x, i)
-		b = 0 - trs2int(y, i)
-		s, p1 = sum_t(a, b, p0)
-		r = int2trs(r, i, s)
-		p0 = p1
-
-		x.t1 >>= 1
-		x.t0 >>= 1
-		y.t1 >>= 1
-		y.t0 >>= 1
-	}
-	return r
-}
-
-// Сложение  в  S (S)+(A*)=>(S)
-// Вычитание в  S (S)-(A*)=>(S)
-// Умножение 0 (S)=>(R); (A*)(R)=>(S)
-// Умножение + (S)+(A*)(R)=>(S)
-// Умножение - (A*)+(S)(R)=>(S)
-// Поразрядное умножение (A*)[x](S)=>(S)
-// Посылка в R (A*)=>(R)
-// Нормализация	Норм.(S)=>(A*); (N)=>(S)
-// Аппаратный сброс.
-
-// Очистить память и регистры
-// виртуальной машины "Сетунь-1958"
-func reset_setun_1958() {
-	//
-	// clean_fram();	/* Очистить  FRAM */
-	// clean_drum();	/* Очистить  DRUM */
-	//
-	clear_full_trs(&K) /* K(1:9) */
-	K.l = 9
-	clear_full_trs(&F) /* F(1:5) */
-	F.l = 5
-	clear_full_trs(&CR) /* K(1:5) */
-	CR.l = 5
-	clear_full_trs(&W) /* W(1:1) */
-	W.l = 1
-	//
-	clear_full_trs(&ph1) /* ph1(1:1) */
-	ph1.l = 1
-	clear_full_trs(&ph2) /* ph2(1:1) */
-	ph2.l = 1
-	clear_full_trs(&S) /* S(1:18) */
-	S.l = 18
-	clear_full_trs(&R) /* R(1:18) */
-	R.l = 18
-	clear_full_trs(&MB) /* MB(1:4) */
-	MB.l = 4
-	//
-	clear_full_trs(&MR) /* Временный регистр данных MR(1:9) */
-	MR.l = 9
-}
-
-// -------------------------------------------------------
-// TRIT Arithmetic  ver. 2.0 for architectures ARM, RISC-V
-// -------------------------------------------------------
-
-// Вызов функций из библиотеки на С
-func testCallC() {
-
-	fmt.Println("-------------------------------")
-
-	// C Library
-	//mystr := C.CString("Hello from a C library function")
-	//C.myPrintFunction(mystr)
-	//defer C.free(unsafe.Pointer(mystr))
-
-	// Inline C
-	C.myPrintFunction2()
-
-	// Inline C math
-	var X C.double
-	X = 0.5432
-	X = C.sin(X)
-	fmt.Println(X)
-
-	s2 := C.s2
-	fmt.Println(s2)
-	s1 := C.s1
-	fmt.Println(s1)
-
-	fmt.Println("-------------------------------")
-}
-
-// ---------------------------------------------------
-// Main
-// ---------------------------------------------------
-func main() {
-
-	fmt.Printf("Test call function trslib -----------\n")
-
-	testCallC()
-
-	fmt.Printf("Test ternary functions -----------\n")
-
-	fmt.Printf("- calculate trit-1  --------------\n")
-	// Троичные переменные
-	var a trits
-	var b trits
-	var c trits
-	var carry trits
-	// Операции на тритами
-	a.SetNil()
-	b.SetFalse()
-	c.SetTrue()
-	carry.SetTrue()
-
-	fmt.Println(" Set trit:")
-	var s trits
-	s = s.SetTrue()
-	fmt.Println(s.SymbChar())
-	s = s.SetNil()
-	fmt.Println(s.SymbChar())
-	s = s.SetFalse()
-	fmt.Println(s.SymbChar())
-
-	s = s.Clear()
-	fmt.Println(s.SymbChar())
-
-	var t3 trits
-	var t2 trits
-	t3 = t3.SetFalse()
-	t2 = t2.SetNil()
-	fmt.Println(cmp_trits(t3, t2))
-	t3 = t3.SetNil()
-	t2 = t2.SetNil()
-	fmt.Println(cmp_trits(t3, t2))
-
-	fmt.Printf("- add_full_t    --------------\n")
-	var aa trits
-	var bb trits
-	var ccarry trits
-	aa = aa.SetFalse()
-	bb = bb.SetFalse()
-	sf, sfc := add_full_slowly_t(aa, bb, ccarry)
-	fmt.Println(sf, sfc)
-
-	fmt.Printf("- calculate trits-32 --------------\n")
-	// Троичные переменные
-	// TODO
-
-	fmt.Printf("--- Operation Setun-1958 ---\n")
-	// Троичные переменные
-	// TODO
-
-	fmt.Printf("--------------------------------\n")
-}
@@ -0,0 +1,293 @@
+/**
+ * Filename: 	truthtable.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package ternary
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ----------------------------------------------------
+// Таблицы истинности функций двух тритов
+// ----------------------------------------------------
+
+// Функция двух тритов F(A,B), заданная таблицей истинности.
+// Таблица из 9 тритов хранится как целое значение в симметричном
+// диапазоне -9841..9841 (как Tryte9): трит k = F(a,b), k = 3*(a+1)+(b+1).
+//
+//	--+----------
+//	  | -  0  +      b
+//	--+----------
+//	- | 0  1  2
+//	0 | 3  4  5      номера тритов k
+//	+ | 6  7  8
+//	--+----------
+//	a
+//
+// Всего 3^9 = 19683 таких функций, из них 3^6 = 729 коммутативных.
+type TruthTable2 int16
+
+// Границы номеров таблиц истинности
+const (
+	TruthTable2Min   = -Tryte9Max
+	TruthTable2Max   = Tryte9Max
+	TruthTable2Count = 2*Tryte9Max + 1 // 19683
+)
+
+// Таблица истинности из значений tbl[3*(a+1)+(b+1)] = F(a,b)
+func TruthTable2Of(tbl [9]int8) TruthTable2 {
+	var n int
+	for k := 8; k >= 0; k-- {
+		n = n*3 + int(tbl[k])
+	}
+	return TruthTable2(n)
+}
+
+// Таблица истинности функции двух тритов
+func MakeTruthTable2(f TritFunc2) TruthTable2 {
+	return TruthTable2Of(table2(f))
+}
+
+// Значения функции: tbl[3*(a+1)+(b+1)] = F(a,b)
+func (t TruthTable2) Table() (tbl [9]int8) {
+	n := int(t)
+	for k := 0; k < 9; k++ {
+		d := wrapTrits(n, 1)
+		tbl[k] = int8(d)
+		n = (n - d) / 3
+	}
+	return tbl
+}
+
+// Значение функции F(a,b) для тритов, заданных целыми -1, 0, +1
+func (t TruthTable2) At(a int8, b int8) int8 {
+	n := int(t) + Tryte9Max // цифры 0, 1, 2 вместо -1, 0, +1
+	for k := 3*(a+1) + (b + 1); k > 0; k-- {
+		n /= 3
+	}
+	return int8(n%3) - 1
+}
+
+// Значение функции F(a,b)
+func (t TruthTable2) Eval(a Trit, b Trit) Trit {
+	return IntToTrit(t.At(a.ToInt(), b.ToInt()))
+}
+
+// Функция двух тритов по таблице истинности
+func (t TruthTable2) Func() TritFunc2 {
+	tbl := t.Table()
+	return func(a Trit, b Trit) Trit {
+		return IntToTrit(tbl[3*(a.ToInt()+1)+(b.ToInt()+1)])
+	}
+}
+
+// Функция с переставленными аргументами: F'(a,b) = F(b,a)
+func (t TruthTable2) Swap() TruthTable2 {
+	tbl := t.Table()
+	var r [9]int8
+	for a := 0; a < 3; a++ {
+		for b := 0; b < 3; b++ {
+			r[3*a+b] = tbl[3*b+a]
+		}
+	}
+	return TruthTable2Of(r)
+}
+
+// Коммутативность: F(a,b) = F(b,a)
+func (t TruthTable2) IsCommutative() bool {
+	return t.Swap() == t
+}
+
+// Ассоциативность: F(F(a,b),c) = F(a,F(b,c))
+func (t TruthTable2) IsAssociative() bool {
+	tbl := t.Table()
+	f := func(a, b int8) int8 { return tbl[3*(a+1)+(b+1)] }
+	for a := int8(-1); a <= 1; a++ {
+		for b := int8(-1); b <= 1; b++ {
+			for c := int8(-1); c <= 1; c++ {
+				if f(f(a, b), c) != f(a, f(b, c)) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// Монотонность по порядку - < 0 < +:
+// из a1 <= a2 и b1 <= b2 следует F(a1,b1) <= F(a2,b2)
+func (t TruthTable2) IsMonotone() bool {
+	tbl := t.Table()
+	for a := 0; a < 3; a++ {
+		for b := 0; b < 3; b++ {
+			if a < 2 && tbl[3*a+b] > tbl[3*(a+1)+b] {
+				return false
+			}
+			if b < 2 && tbl[3*a+b] > tbl[3*a+b+1] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Идемпотентность: F(a,a) = a
+func (t TruthTable2) IsIdempotent() bool {
+	tbl := t.Table()
+	return tbl[0] == -1 && tbl[4] == 0 && tbl[8] == 1
+}
+
+// Таблица истинности строкой из 9 символов '-','0','+'
+// по строкам a = -, 0, + (трит k = 0 слева)
+func (t TruthTable2) String() string {
+	var sb strings.Builder
+	for _, v := range t.Table() {
+		sb.WriteString(IntToTrit(v).SymbTrit())
+	}
+	return sb.String()
+}
+
+// Разобрать таблицу истинности из 9 символов '-','0','+' (как String)
+func ParseTruthTable2(s string) (TruthTable2, error) {
+	if len(s) != 9 {
+		return 0, fmt.Errorf("%w: truth table %q must have 9 trits", ErrSyntax, s)
+	}
+	var tbl [9]int8
+	for k := range tbl {
+		t, err := ParseTrit(s[k:k+1], AlphabetTrit)
+		if err != nil {
+			return 0, fmt.Errorf("%w: truth table %q", ErrSyntax, s)
+		}
+		tbl[k] = t.ToInt()
+	}
+	return TruthTable2Of(tbl), nil
+}
+
+// Все функции двух тритов, для которых pred возвращает true
+// (при pred == nil — все 19683 функции) в порядке возрастания номера
+func TruthTables2(pred func(t TruthTable2) bool) []TruthTable2 {
+	var r []TruthTable2
+	for n := TruthTable2Min; n <= TruthTable2Max; n++ {
+		if t := TruthTable2(n); pred == nil || pred(t) {
+			r = append(r, t)
+		}
+	}
+	return r
+}
+
+// Поразрядно применить функцию двух тритов, заданную таблицей,
+// к числам x и y (как Map2)
+func (x Word) MapTable2(y Word, t TruthTable2) Word {
+	return x.map2(y, t.Table())
+}
+
+// ----------------------------------------------------
+// Реестр именованных функций двух тритов
+// ----------------------------------------------------
+
+type namedTruthTable2 struct {
+	name string
+	t    TruthTable2
+}
+
+var (
+	truthTablesMu sync.RWMutex
+	truthTables2  []namedTruthTable2
+)
+
+// Найти запись реестра по имени (вызывается под truthTablesMu)
+func lookupTruthTable2(name string) (TruthTable2, bool) {
+	for _, e := range truthTables2 {
+		if strings.EqualFold(e.name, name) {
+			return e.t, true
+		}
+	}
+	return 0, false
+}
+
+// Зарегистрировать функцию двух тритов под именем name
+// Повторная регистрация имени вызывает панику.
+func RegisterTruthTable2(name string, t TruthTable2) {
+	truthTablesMu.Lock()
+	defer truthTablesMu.Unlock()
+	if _, ok := lookupTruthTable2(name); ok {
+		panic("ternary: RegisterTruthTable2 called twice for " + name)
+	}
+	truthTables2 = append(truthTables2, namedTruthTable2{name, t})
+}
+
+// Найти функцию двух тритов по имени (без учета регистра)
+func LookupTruthTable2(name string) (TruthTable2, bool) {
+	truthTablesMu.RLock()
+	defer truthTablesMu.RUnlock()
+	return lookupTruthTable2(name)
+}
+
+// Имя функции в реестре (первое зарегистрированное), "" если нет
+func (t TruthTable2) Name() string {
+	truthTablesMu.RLock()
+	defer truthTablesMu.RUnlock()
+	for _, e := range truthTables2 {
+		if e.t == t {
+			return e.name
+		}
+	}
+	return ""
+}
+
+// Имена всех зарегистрированных функций в порядке регистрации
+func TruthTable2Names() []string {
+	truthTablesMu.RLock()
+	defer truthTablesMu.RUnlock()
+	r := make([]string, len(truthTables2))
+	for i, e := range truthTables2 {
+		r[i] = e.name
+	}
+	return r
+}
+
+func init() {
+	for _, e := range []struct {
+		name string
+		f    TritFunc2
+	}{
+		{"And", And},
+		{"Or", Or},
+		{"Xor", Xor},
+		{"Eqv", Eqv},
+		{"Nand", Nand},
+		{"Nor", Nor},
+		{"Imp", Imp},
+		{"Xmax", Xmax},
+		{"Ixmax", Ixmax},
+		{"Mean", Mean},
+		{"Magnitude", Magnitude},
+		{"Mul", Mul},
+		{"AddMod", AddMod},
+		{"CarryAddMod", CarryAddMod},
+		{"AddSatiation", AddSatiation},
+		{"Webb", Webb},
+		{"IdentityStrict", IdentityStrict},
+		{"Weak", Weak},
+		{"ConjunctionLukashevichStrong", ConjunctionLukashevichStrong},
+		{"LukashevichImplication", LukashevichImplication},
+		{"KleeneConjunction", KleeneConjunction},
+		{"KleeneImplication", KleeneImplication},
+		{"GoedelImplication", GoedelImplication},
+		{"MaterialImplication", MaterialImplication},
+		{"FollowingBrusentsov", FollowingBrusentsov},
+	} {
+		RegisterTruthTable2(e.name, MakeTruthTable2(e.f))
+	}
+}
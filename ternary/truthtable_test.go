@@ -0,0 +1,107 @@
+package ternary
+
+import (
+	"math/rand"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func Test_truth_table2_registry(t *testing.T) {
+	for _, name := range TruthTable2Names() {
+		tt, ok := LookupTruthTable2(name)
+		if !ok {
+			t.Fatalf("LookupTruthTable2(%q) not found", name)
+		}
+		f := tt.Func()
+		for a := int8(-1); a <= 1; a++ {
+			for b := int8(-1); b <= 1; b++ {
+				x, y := IntToTrit(a), IntToTrit(b)
+				if tt.Eval(x, y) != f(x, y) || tt.At(a, b) != f(x, y).ToInt() {
+					t.Errorf("%s(%d, %d): Eval = %d, Func = %d", name, a, b, tt.At(a, b), f(x, y).ToInt())
+				}
+			}
+		}
+	}
+	webb, ok := LookupTruthTable2("webb")
+	if !ok || webb != MakeTruthTable2(Webb) || webb.String() != "0+-++----" {
+		t.Errorf("webb = %v, %v", webb, ok)
+	}
+	if n := MakeTruthTable2(Webb).Name(); n != "Webb" {
+		t.Errorf("Webb.Name() = %q", n)
+	}
+	if !MakeTruthTable2(And).IsMonotone() || !MakeTruthTable2(Or).IsAssociative() || MakeTruthTable2(Imp).IsCommutative() {
+		t.Errorf("And/Or/Imp properties")
+	}
+}
+
+func Test_truth_table2_registry_concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := "concurrent" + strconv.Itoa(i)
+			RegisterTruthTable2(name, TruthTable2(1000+i))
+			if _, ok := LookupTruthTable2(name); !ok {
+				t.Errorf("LookupTruthTable2(%q) not found", name)
+			}
+			_ = TruthTable2(1000 + i).Name()
+			_ = TruthTable2Names()
+		}(i)
+	}
+	wg.Wait()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("duplicate registration: no panic")
+		}
+	}()
+	RegisterTruthTable2("CONCURRENT0", 0)
+}
+
+func Test_truth_table2_classify(t *testing.T) {
+	if n := len(TruthTables2(nil)); n != TruthTable2Count {
+		t.Errorf("all = %d", n)
+	}
+	for _, c := range []struct {
+		name string
+		pred func(TruthTable2) bool
+		want int
+	}{
+		{"commutative", TruthTable2.IsCommutative, 729},
+		{"associative", TruthTable2.IsAssociative, 113},
+		{"monotone", TruthTable2.IsMonotone, 175},
+		{"idempotent", TruthTable2.IsIdempotent, 729},
+	} {
+		if n := len(TruthTables2(c.pred)); n != c.want {
+			t.Errorf("%s = %d, want %d", c.name, n, c.want)
+		}
+	}
+}
+
+func Test_truth_table2_string(t *testing.T) {
+	for n := TruthTable2Min; n <= TruthTable2Max; n += 37 {
+		tt := TruthTable2(n)
+		if TruthTable2Of(tt.Table()) != tt {
+			t.Fatalf("TruthTable2Of(%d.Table())", n)
+		}
+		p, err := ParseTruthTable2(tt.String())
+		if err != nil || p != tt {
+			t.Fatalf("ParseTruthTable2(%q) = %d, %v", tt.String(), p, err)
+		}
+	}
+	if _, err := ParseTruthTable2("+-0"); err == nil {
+		t.Errorf("ParseTruthTable2 short: no error")
+	}
+}
+
+func Test_truth_table2_map(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		tt := TruthTable2(rnd.Intn(TruthTable2Count) + TruthTable2Min)
+		x, y := randWord(rnd, uint8(rnd.Intn(TritsMax+1))), randWord(rnd, uint8(rnd.Intn(TritsMax+1)))
+		if r, w := x.MapTable2(y, tt), x.Map2(y, tt.Func()); r != w {
+			t.Fatalf("MapTable2(%v, %v, %v) = %v, want %v", x, y, tt, r, w)
+		}
+	}
+}
@@ -0,0 +1,355 @@
+/**
+ * Filename: 	truthtablen.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package ternary
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ----------------------------------------------------
+// Таблицы истинности функций n тритов
+// ----------------------------------------------------
+
+// Функция трех тритов (Majority, FullAdderSum, ...)
+type TritFunc3 func(a Trit, b Trit, c Trit) Trit
+
+// Функция произвольного числа тритов
+type TritFuncN func(args ...Trit) Trit
+
+// Функция n тритов F(a[0],...,a[n-1]), заданная таблицей истинности
+// из 3^n тритов. Номер строки k = Σ (a[i]+1)*3^(n-1-i): первый аргумент
+// старший, как в TruthTable2. Нулевое значение — константа 0 (n = 0).
+type TruthTableN struct {
+	n   int
+	tbl []int8
+}
+
+// Число строк таблицы функции n тритов
+func tableRows(n int) int {
+	if n < 0 {
+		panic("ternary: negative arity")
+	}
+	r := 1
+	for ; n > 0; n-- {
+		r *= 3
+	}
+	return r
+}
+
+// Таблица истинности функции n тритов из значений tbl[k]
+// Длина tbl должна быть 3^n.
+func TruthTableNOf(n int, tbl []int8) TruthTableN {
+	if len(tbl) != tableRows(n) {
+		panic(fmt.Sprintf("ternary: truth table of arity %d must have %d rows", n, tableRows(n)))
+	}
+	r := TruthTableN{n, make([]int8, len(tbl))}
+	for k, v := range tbl {
+		r.tbl[k] = IntToTrit(v).ToInt()
+	}
+	return r
+}
+
+// Таблица истинности функции n тритов
+func MakeTruthTableN(n int, f TritFuncN) TruthTableN {
+	r := TruthTableN{n, make([]int8, tableRows(n))}
+	args := make([]Trit, n)
+	for k := range r.tbl {
+		for i, m := n-1, k; i >= 0; i, m = i-1, m/3 {
+			args[i] = IntToTrit(int8(m%3) - 1)
+		}
+		r.tbl[k] = f(args...).ToInt()
+	}
+	return r
+}
+
+// Таблица истинности функции одного трита
+func MakeTruthTable1(f TritFunc1) TruthTableN {
+	return MakeTruthTableN(1, func(a ...Trit) Trit { return f(a[0]) })
+}
+
+// Таблица истинности функции трех тритов
+func MakeTruthTable3(f TritFunc3) TruthTableN {
+	return MakeTruthTableN(3, func(a ...Trit) Trit { return f(a[0], a[1], a[2]) })
+}
+
+// Таблица истинности функции двух тритов как TruthTableN
+func (t TruthTable2) TableN() TruthTableN {
+	tbl := t.Table()
+	return TruthTableNOf(2, tbl[:])
+}
+
+// Функция двух тритов из TruthTableN (ok == false, если арность не 2)
+func (t TruthTableN) TruthTable2() (r TruthTable2, ok bool) {
+	if t.n != 2 {
+		return 0, false
+	}
+	var tbl [9]int8
+	copy(tbl[:], t.tbl)
+	return TruthTable2Of(tbl), true
+}
+
+// Число аргументов функции
+func (t TruthTableN) Arity() int {
+	return t.n
+}
+
+// Значения функции по строкам таблицы
+func (t TruthTableN) Table() []int8 {
+	if t.tbl == nil {
+		return []int8{0}
+	}
+	return append([]int8(nil), t.tbl...)
+}
+
+// Равенство таблиц истинности
+func (t TruthTableN) Equal(u TruthTableN) bool {
+	if t.n != u.n {
+		return false
+	}
+	ut := u.Table()
+	for k, v := range t.Table() {
+		if ut[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Значение функции для тритов, заданных целыми -1, 0, +1
+func (t TruthTableN) At(args ...int8) int8 {
+	if len(args) != t.n {
+		panic(fmt.Sprintf("ternary: function of arity %d called with %d arguments", t.n, len(args)))
+	}
+	if t.n == 0 {
+		return t.Table()[0]
+	}
+	k := 0
+	for _, a := range args {
+		k = 3*k + int(IntToTrit(a).ToInt()+1)
+	}
+	return t.tbl[k]
+}
+
+// Значение функции F(args...)
+func (t TruthTableN) Eval(args ...Trit) Trit {
+	v := make([]int8, len(args))
+	for i, a := range args {
+		v[i] = a.ToInt()
+	}
+	return IntToTrit(t.At(v...))
+}
+
+// Функция тритов по таблице истинности
+func (t TruthTableN) Func() TritFuncN {
+	return t.Eval
+}
+
+// Функция одного трита (паника, если арность не 1)
+func (t TruthTableN) Func1() TritFunc1 {
+	t.mustArity(1)
+	return func(a Trit) Trit { return t.Eval(a) }
+}
+
+// Функция двух тритов (паника, если арность не 2)
+func (t TruthTableN) Func2() TritFunc2 {
+	t.mustArity(2)
+	return func(a Trit, b Trit) Trit { return t.Eval(a, b) }
+}
+
+// Функция трех тритов (паника, если арность не 3)
+func (t TruthTableN) Func3() TritFunc3 {
+	t.mustArity(3)
+	return func(a Trit, b Trit, c Trit) Trit { return t.Eval(a, b, c) }
+}
+
+func (t TruthTableN) mustArity(n int) {
+	if t.n != n {
+		panic(fmt.Sprintf("ternary: function of arity %d used as arity %d", t.n, n))
+	}
+}
+
+// Суперпозиция F(G1(x...), ..., Gm(x...))
+// Число функций gs равно арности f, все gs одной арности k,
+// арность результата k.
+func Compose(f TruthTableN, gs ...TruthTableN) TruthTableN {
+	if len(gs) != f.n {
+		panic(fmt.Sprintf("ternary: Compose: function of arity %d with %d arguments", f.n, len(gs)))
+	}
+	k := 0
+	if len(gs) > 0 {
+		k = gs[0].n
+	}
+	tbls := make([][]int8, len(gs))
+	for i, g := range gs {
+		g.mustArity(k)
+		tbls[i] = g.Table()
+	}
+	r := TruthTableN{k, make([]int8, tableRows(k))}
+	v := make([]int8, len(gs))
+	for row := range r.tbl {
+		for i, tbl := range tbls {
+			v[i] = tbl[row]
+		}
+		r.tbl[row] = f.At(v...)
+	}
+	return r
+}
+
+// Таблица истинности строкой из 3^n символов '-','0','+' по строкам k
+func (t TruthTableN) String() string {
+	var sb strings.Builder
+	for _, v := range t.Table() {
+		sb.WriteString(IntToTrit(v).SymbTrit())
+	}
+	return sb.String()
+}
+
+// Разобрать таблицу истинности из 3^n символов '-','0','+' (как String)
+func ParseTruthTableN(s string) (TruthTableN, error) {
+	n := 0
+	for rows := 1; rows < len(s); rows *= 3 {
+		n++
+	}
+	if len(s) != tableRows(n) {
+		return TruthTableN{}, fmt.Errorf("%w: truth table %q length is not a power of 3", ErrSyntax, s)
+	}
+	tbl := make([]int8, len(s))
+	for k := range tbl {
+		t, err := ParseTrit(s[k:k+1], AlphabetTrit)
+		if err != nil {
+			return TruthTableN{}, fmt.Errorf("%w: truth table %q", ErrSyntax, s)
+		}
+		tbl[k] = t.ToInt()
+	}
+	return TruthTableN{n, tbl}, nil
+}
+
+// Таблица истинности в виде сетки, как в комментариях к функциям тритов:
+//
+//	--+----------
+//	  | -  0  +
+//	--+----------
+//	- | 0  +  -
+//	0 | +  +  -
+//	+ | -  -  -
+//	--+----------
+//
+// Строки — предпоследний аргумент, столбцы — последний. Для n > 2
+// выводится 3^(n-2) сеток, каждой предшествуют значения первых n-2
+// аргументов. Для n = 1 выводится одна строка значений.
+func (t TruthTableN) Grid() string {
+	const line = "--+----------\n"
+	var sb strings.Builder
+	tbl := t.Table()
+	if t.n == 0 {
+		sb.WriteString(IntToTrit(tbl[0]).SymbTrit() + "\n")
+		return sb.String()
+	}
+	rows := 1
+	if t.n > 1 {
+		rows = 3
+	}
+	for base := 0; base < len(tbl); base += 3 * rows {
+		if t.n > 2 {
+			prefix := make([]string, t.n-2)
+			for i, m := t.n-3, base/9; i >= 0; i, m = i-1, m/3 {
+				prefix[i] = IntToTrit(int8(m%3) - 1).SymbTrit()
+			}
+			sb.WriteString(strings.Join(prefix, " ") + " :\n")
+		}
+		sb.WriteString(line)
+		sb.WriteString("  | -  0  +\n")
+		sb.WriteString(line)
+		for r := 0; r < rows; r++ {
+			label := " "
+			if t.n > 1 {
+				label = IntToTrit(int8(r) - 1).SymbTrit()
+			}
+			row := tbl[base+3*r : base+3*r+3]
+			fmt.Fprintf(&sb, "%s | %s  %s  %s\n", label,
+				IntToTrit(row[0]).SymbTrit(), IntToTrit(row[1]).SymbTrit(), IntToTrit(row[2]).SymbTrit())
+		}
+		sb.WriteString(line)
+	}
+	return sb.String()
+}
+
+// Таблица истинности в виде сетки
+func (t TruthTable2) Grid() string {
+	return t.TableN().Grid()
+}
+
+// Все 27 функций одного трита в порядке строк String ("---" ... "+++")
+func UnaryTruthTables() []TruthTableN {
+	r := make([]TruthTableN, 27)
+	for i := range r {
+		tbl := make([]int8, 3)
+		for k, m := 2, i; k >= 0; k, m = k-1, m/3 {
+			tbl[k] = int8(m%3) - 1
+		}
+		r[i] = TruthTableN{1, tbl}
+	}
+	return r
+}
+
+// ----------------------------------------------------
+// Функции трех и четырех тритов
+// ----------------------------------------------------
+
+// Мажоритарная функция (медиана): значение, которое имеют
+// не менее двух аргументов, иначе 0
+func Majority(a Trit, b Trit, c Trit) Trit {
+	x, y, z := a.ToInt(), b.ToInt(), c.ToInt()
+	if x > y {
+		x, y = y, x
+	}
+	if y > z {
+		y = z
+	}
+	if x > y {
+		y = x
+	}
+	return IntToTrit(y)
+}
+
+// Мультиплексор: s = - выбирает a, s = 0 выбирает b, s = + выбирает c
+func Mux(s Trit, a Trit, b Trit, c Trit) Trit {
+	switch {
+	case s.IsFalse():
+		return a
+	case s.IsNil():
+		return b
+	}
+	return c
+}
+
+// Сумма полного троичного сумматора (AddFull)
+func FullAdderSum(a Trit, b Trit, c Trit) Trit {
+	s, _ := AddFull(a, b, c)
+	return s
+}
+
+// Перенос полного троичного сумматора (AddFull)
+func FullAdderCarry(a Trit, b Trit, c Trit) Trit {
+	_, p := AddFull(a, b, c)
+	return p
+}
+
+// Таблицы истинности функций трех и четырех тритов
+var (
+	TruthTableMajority       = MakeTruthTable3(Majority)
+	TruthTableFullAdderSum   = MakeTruthTable3(FullAdderSum)
+	TruthTableFullAdderCarry = MakeTruthTable3(FullAdderCarry)
+	TruthTableMux            = MakeTruthTableN(4, func(a ...Trit) Trit { return Mux(a[0], a[1], a[2], a[3]) })
+)
@@ -0,0 +1,75 @@
+package ternary
+
+import (
+	"testing"
+)
+
+func Test_truth_tablen_grid(t *testing.T) {
+	want := `--+----------
+  | -  0  +
+--+----------
+- | 0  +  -
+0 | +  +  -
++ | -  -  -
+--+----------
+`
+	if g := MakeTruthTable2(Webb).Grid(); g != want {
+		t.Errorf("Webb grid:\n%s", g)
+	}
+	if g := MakeTruthTable1(Not).Grid(); g != "--+----------\n  | -  0  +\n--+----------\n  | +  0  -\n--+----------\n" {
+		t.Errorf("Not grid:\n%s", g)
+	}
+	if g := TruthTableMajority.Grid(); len(g) != 3*(len(want)+4) {
+		t.Errorf("Majority grid:\n%s", g)
+	}
+}
+
+func Test_truth_tablen_eval(t *testing.T) {
+	for _, a := range []int8{-1, 0, 1} {
+		for _, b := range []int8{-1, 0, 1} {
+			for _, c := range []int8{-1, 0, 1} {
+				x, y, z := IntToTrit(a), IntToTrit(b), IntToTrit(c)
+				s, p := AddFull(x, y, z)
+				if TruthTableFullAdderSum.Eval(x, y, z) != s || TruthTableFullAdderCarry.Func3()(x, y, z) != p {
+					t.Errorf("full adder(%d, %d, %d)", a, b, c)
+				}
+				if a+b+c != 3*p.ToInt()+s.ToInt() {
+					t.Errorf("AddFull(%d, %d, %d) = %d, %d", a, b, c, s.ToInt(), p.ToInt())
+				}
+				if (a == b || a == c) && TruthTableMajority.At(a, b, c) != a {
+					t.Errorf("Majority(%d, %d, %d) = %d", a, b, c, TruthTableMajority.At(a, b, c))
+				}
+				if TruthTableMux.At(-1, a, b, c) != a || TruthTableMux.At(0, a, b, c) != b || TruthTableMux.At(1, a, b, c) != c {
+					t.Errorf("Mux(%d, %d, %d)", a, b, c)
+				}
+			}
+		}
+	}
+	if n := len(UnaryTruthTables()); n != 27 {
+		t.Errorf("unary = %d", n)
+	}
+	if u := UnaryTruthTables()[5]; !u.Equal(MakeTruthTable1(func(a Trit) Trit { return a })) {
+		t.Errorf("unary[5] = %v", u)
+	}
+}
+
+func Test_truth_tablen_compose(t *testing.T) {
+	not := MakeTruthTable1(Not)
+	and := MakeTruthTable2(And).TableN()
+	first := TruthTableNOf(2, []int8{-1, -1, -1, 0, 0, 0, 1, 1, 1})
+	second := TruthTableNOf(2, []int8{-1, 0, 1, -1, 0, 1, -1, 0, 1})
+	// De Morgan: not(and(not a, not b)) = or(a, b)
+	or := Compose(not, Compose(and, Compose(not, first), Compose(not, second)))
+	if w, _ := or.TruthTable2(); w != MakeTruthTable2(Or) {
+		t.Errorf("not(and(not a, not b)) = %v, want %v", or, MakeTruthTable2(Or))
+	}
+	if !Compose(and, first, second).Equal(and) {
+		t.Errorf("and(a, b) != and")
+	}
+	if p, err := ParseTruthTableN(TruthTableMux.String()); err != nil || !p.Equal(TruthTableMux) || p.Arity() != 4 {
+		t.Errorf("ParseTruthTableN(mux) = %v, %v", p, err)
+	}
+	if _, err := ParseTruthTableN("+-"); err == nil {
+		t.Errorf("ParseTruthTableN(+-): no error")
+	}
+}
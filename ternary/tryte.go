@@ -0,0 +1,251 @@
+/**
+ * Filename: 	tryte.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package ternary
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ----------------------------------------------------
+// TRYTES
+// ----------------------------------------------------
+
+// Трайты хранятся как целое значение в симметричном диапазоне.
+// Арифметика выполняется по модулю 3^6 или 3^9 (перенос отбрасывается),
+// как Word.Add для слова той же длины.
+// Преобразование типа Tryte6(n) не проверяет диапазон: значение
+// из внешних данных создается NewTryte6/NewTryte9 (ошибка ErrRange)
+// или MakeTryte6/MakeTryte9 (младшие триты числа).
+
+// Трайт из 6 тритов: -364..364
+type Tryte6 int16
+
+// Трайт из 9 тритов (короткое слово "Сетунь-1958" K(1:9), MR(1:9)): -9841..9841
+type Tryte9 int16
+
+// Границы трайтов
+const (
+	Tryte6Max = 364  // (3^6-1)/2
+	Tryte9Max = 9841 // (3^9-1)/2
+)
+
+// Цифры 27-ричной (heptavigesimal) симметричной системы -13..13:
+// 'M'..'Z' (без 'O') для -13..-1, '0', '1'..'9','A'..'D' для 1..13.
+const heptaDigits = "MNPQRSTUVWXYZ0123456789ABCD"
+
+// Привести n к симметричному диапазону по модулю 3^k
+func wrapTrits(n int, max int) int {
+	m := 2*max + 1
+	r := (n + max) % m
+	if r < 0 {
+		r += m
+	}
+	return r - max
+}
+
+// Троичное число из целого значения длиной l тритов
+func wordOfInt(n int, l uint8) Word {
+	x := NewWord(l)
+	for i := uint8(0); i < l; i++ {
+		d := wrapTrits(n, 1)
+		x = int2trs(x, i, int8(d))
+		n = (n - d) / 3
+	}
+	return x
+}
+
+// 27-ричная запись значения из k цифр
+func heptavigesimal(n int, k int) string {
+	b := make([]byte, k)
+	for i := k - 1; i >= 0; i-- {
+		d := wrapTrits(n, 13)
+		b[i] = heptaDigits[d+13]
+		n = (n - d) / 27
+	}
+	return string(b)
+}
+
+// Разобрать 27-ричную запись из k цифр
+func parseHeptavigesimal(s string, k int) (int, error) {
+	if len(s) != k {
+		return 0, fmt.Errorf("%w: %q must have %d heptavigesimal digits", ErrSyntax, s, k)
+	}
+	n := 0
+	for i := 0; i < k; i++ {
+		d := strings.IndexByte(heptaDigits, s[i])
+		if d < 0 {
+			return 0, fmt.Errorf("%w: invalid heptavigesimal digit %q", ErrSyntax, s[i])
+		}
+		n = n*27 + d - 13
+	}
+	return n, nil
+}
+
+// --------------------------
+// Tryte6
+
+// Трайт со значением n; ErrRange, если n вне -364..364
+func NewTryte6(n int) (Tryte6, error) {
+	if n < -Tryte6Max || n > Tryte6Max {
+		return 0, fmt.Errorf("%w: %d is not a 6-trit tryte", ErrRange, n)
+	}
+	return Tryte6(n), nil
+}
+
+// Лежит ли значение трайта в диапазоне -364..364
+func (t Tryte6) Valid() bool {
+	return t >= -Tryte6Max && t <= Tryte6Max
+}
+
+// Трайт из младших 6 тритов троичного числа
+func MakeTryte6(x Word) Tryte6 {
+	x.l = 6
+	return Tryte6(x.norm().Int64())
+}
+
+// Преобразовать трайт в троичное число длиной 6 тритов
+func (t Tryte6) Word() Word {
+	return wordOfInt(int(t), 6)
+}
+
+// Трит в позиции i (0 — младший)
+func (t Tryte6) Trit(i uint8) int8 {
+	return t.Word().GetTrit(i)
+}
+
+// Сложение по модулю 3^6
+func (t Tryte6) Add(u Tryte6) Tryte6 {
+	return Tryte6(wrapTrits(int(t)+int(u), Tryte6Max))
+}
+
+// Вычитание по модулю 3^6
+func (t Tryte6) Sub(u Tryte6) Tryte6 {
+	return Tryte6(wrapTrits(int(t)-int(u), Tryte6Max))
+}
+
+// Умножение по модулю 3^6 (младшие 6 тритов произведения)
+func (t Tryte6) Mul(u Tryte6) Tryte6 {
+	return Tryte6(wrapTrits(int(t)*int(u), Tryte6Max))
+}
+
+// Отрицание (инверсия всех тритов)
+func (t Tryte6) Neg() Tryte6 {
+	return -t
+}
+
+// Сравнить трайты: -1, 0, +1
+func (t Tryte6) Cmp(u Tryte6) int {
+	switch {
+	case t < u:
+		return -1
+	case t > u:
+		return 1
+	}
+	return 0
+}
+
+// 27-ричная запись трайта (2 цифры)
+func (t Tryte6) Heptavigesimal() string {
+	return heptavigesimal(int(t), 2)
+}
+
+// Разобрать 27-ричную запись трайта (2 цифры)
+func ParseTryte6(s string) (Tryte6, error) {
+	n, err := parseHeptavigesimal(s, 2)
+	return Tryte6(n), err
+}
+
+// Строка трайта тритами '-','0','+'
+func (t Tryte6) String() string {
+	return t.Word().String()
+}
+
+// --------------------------
+// Tryte9
+
+// Трайт со значением n; ErrRange, если n вне -9841..9841
+func NewTryte9(n int) (Tryte9, error) {
+	if n < -Tryte9Max || n > Tryte9Max {
+		return 0, fmt.Errorf("%w: %d is not a 9-trit tryte", ErrRange, n)
+	}
+	return Tryte9(n), nil
+}
+
+// Лежит ли значение трайта в диапазоне -9841..9841
+func (t Tryte9) Valid() bool {
+	return t >= -Tryte9Max && t <= Tryte9Max
+}
+
+// Трайт из младших 9 тритов троичного числа
+func MakeTryte9(x Word) Tryte9 {
+	x.l = 9
+	return Tryte9(x.norm().Int64())
+}
+
+// Преобразовать трайт в троичное число длиной 9 тритов
+func (t Tryte9) Word() Word {
+	return wordOfInt(int(t), 9)
+}
+
+// Трит в позиции i (0 — младший)
+func (t Tryte9) Trit(i uint8) int8 {
+	return t.Word().GetTrit(i)
+}
+
+// Сложение по модулю 3^9
+func (t Tryte9) Add(u Tryte9) Tryte9 {
+	return Tryte9(wrapTrits(int(t)+int(u), Tryte9Max))
+}
+
+// Вычитание по модулю 3^9
+func (t Tryte9) Sub(u Tryte9) Tryte9 {
+	return Tryte9(wrapTrits(int(t)-int(u), Tryte9Max))
+}
+
+// Умножение по модулю 3^9 (младшие 9 тритов произведения)
+func (t Tryte9) Mul(u Tryte9) Tryte9 {
+	return Tryte9(wrapTrits(int(t)*int(u), Tryte9Max))
+}
+
+// Отрицание (инверсия всех тритов)
+func (t Tryte9) Neg() Tryte9 {
+	return -t
+}
+
+// Сравнить трайты: -1, 0, +1
+func (t Tryte9) Cmp(u Tryte9) int {
+	switch {
+	case t < u:
+		return -1
+	case t > u:
+		return 1
+	}
+	return 0
+}
+
+// 27-ричная запись трайта (3 цифры)
+func (t Tryte9) Heptavigesimal() string {
+	return heptavigesimal(int(t), 3)
+}
+
+// Разобрать 27-ричную запись трайта (3 цифры)
+func ParseTryte9(s string) (Tryte9, error) {
+	n, err := parseHeptavigesimal(s, 3)
+	return Tryte9(n), err
+}
+
+// Строка трайта тритами '-','0','+'
+func (t Tryte9) String() string {
+	return t.Word().String()
+}
@@ -0,0 +1,89 @@
+package ternary
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_tryte9(t *testing.T) {
+	a, b := Tryte9(9841), Tryte9(1)
+	if s := a.Add(b); s != -9841 {
+		t.Errorf("9841 + 1 = %d, want -9841", s)
+	}
+	if m := Tryte9(100).Mul(Tryte9(-200)); m != Tryte9(wrapTrits(-20000, Tryte9Max)) {
+		t.Errorf("100 * -200 = %d", m)
+	}
+	for n := -Tryte9Max; n <= Tryte9Max; n += 37 {
+		x := Tryte9(n)
+		w := x.Word()
+		if w.Len() != 9 || w.Int64() != int64(n) || MakeTryte9(w) != x {
+			t.Fatalf("Tryte9(%d).Word() = %v", n, w)
+		}
+		h := x.Heptavigesimal()
+		y, err := ParseTryte9(h)
+		if err != nil || y != x {
+			t.Fatalf("ParseTryte9(%q) = %d, %v; want %d", h, y, err, n)
+		}
+		// каждая 27-ричная цифра соответствует трем тритам
+		for i := 0; i < 3; i++ {
+			d := int(w.GetTrit(uint8(3*i))) + 3*int(w.GetTrit(uint8(3*i+1))) + 9*int(w.GetTrit(uint8(3*i+2)))
+			if h[2-i] != heptaDigits[d+13] {
+				t.Fatalf("Tryte9(%d) = %q: digit %d != %d", n, h, i, d)
+			}
+		}
+	}
+	if h := Tryte9(0).Heptavigesimal(); h != "000" {
+		t.Errorf("0 = %q", h)
+	}
+	if h := Tryte9(-9841).Heptavigesimal(); h != "MMM" {
+		t.Errorf("-9841 = %q", h)
+	}
+	if _, err := ParseTryte9("0O0"); err == nil {
+		t.Errorf("ParseTryte9(0O0): no error")
+	}
+}
+
+func Test_tryte6(t *testing.T) {
+	x, _ := FromInt64(1000, 9)
+	if tr := MakeTryte6(x); tr != Tryte6(wrapTrits(1000, Tryte6Max)) || tr.Word().Len() != 6 {
+		t.Errorf("MakeTryte6(1000) = %d", tr)
+	}
+	if d := Tryte6(-364).Sub(1); d != 364 {
+		t.Errorf("-364 - 1 = %d", d)
+	}
+	if Tryte6(5).Cmp(Tryte6(-5)) != 1 || Tryte6(5).Neg() != -5 {
+		t.Errorf("Cmp/Neg")
+	}
+	if h := Tryte6(13).Heptavigesimal(); h != "0D" {
+		t.Errorf("13 = %q", h)
+	}
+}
+
+func Test_new_tryte(t *testing.T) {
+	for _, n := range []int{-Tryte6Max, 0, Tryte6Max} {
+		if x, err := NewTryte6(n); err != nil || int(x) != n || !x.Valid() {
+			t.Errorf("NewTryte6(%d) = %d, %v", n, x, err)
+		}
+	}
+	for _, n := range []int{-Tryte6Max - 1, Tryte6Max + 1, 1000} {
+		if _, err := NewTryte6(n); !errors.Is(err, ErrRange) {
+			t.Errorf("NewTryte6(%d) error = %v", n, err)
+		}
+		if Tryte6(n).Valid() {
+			t.Errorf("Tryte6(%d).Valid() = true", n)
+		}
+	}
+	for _, n := range []int{-Tryte9Max, 0, Tryte9Max} {
+		if x, err := NewTryte9(n); err != nil || int(x) != n || !x.Valid() {
+			t.Errorf("NewTryte9(%d) = %d, %v", n, x, err)
+		}
+	}
+	for _, n := range []int{-Tryte9Max - 1, Tryte9Max + 1, 32767} {
+		if _, err := NewTryte9(n); !errors.Is(err, ErrRange) {
+			t.Errorf("NewTryte9(%d) error = %v", n, err)
+		}
+		if Tryte9(n).Valid() {
+			t.Errorf("Tryte9(%d).Valid() = true", n)
+		}
+	}
+}
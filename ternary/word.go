@@ -0,0 +1,470 @@
+/**
+ * Filename: 	word.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 28.08.2021
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package ternary
+
+// ----------------------------------------------------
+// 32-TRITS
+// ----------------------------------------------------
+const TritsMax = 32
+
+// Троичный тип данных
+type Word struct {
+	l  uint8  // длина троичного числа в тритах
+	t1 uint32 // двоичное битовое поле троичного числа {FALSE,TRUE}
+	t0 uint32 // двоичное битовое поле троичного числа {NIL}
+	// if t0[i] == 0 then trit[i] = NIL emdif
+	// if (t0[i] == 1) && (t1[i] == 0)  then trit[i] = FALSE emdif //
+	// if (t0[i] == 1) && (t1[i] == 1)  then trit[i] = TRUE emdif //
+}
+
+// Создать троичное число длиной l тритов
+func NewWord(l uint8) Word {
+	if l > TritsMax {
+		l = TritsMax
+	}
+	return Word{l: l}
+}
+
+// Метод вернуть длину троичного числа в тритах
+func (ts Word) Len() uint8 {
+	return ts.l
+}
+
+// Маска разрядов троичного числа длиной l
+func mask(l uint8) uint32 {
+	if l >= TritsMax {
+		return ^uint32(0)
+	}
+	return (1 << l) - 1
+}
+
+// Очистить триты за пределами длины числа
+func (ts Word) norm() Word {
+	if ts.l > TritsMax {
+		ts.l = TritsMax
+	}
+	ts.t0 &= mask(ts.l)
+	ts.t1 &= ts.t0
+	return ts
+}
+
+// Метод получить трит в позиции троичного числа
+func (ts Word) GetTrit(p uint8) int8 {
+	if p >= TritsMax {
+		return 0
+	}
+
+	if (ts.t0 & (1 << p)) == 0 {
+		return 0
+	} else if (ts.t1 & (1 << p)) != 0 {
+		return 1
+	}
+	return -1
+}
+
+// Метод установить трит в позиции троичного числа
+// Позиции p >= Len() игнорируются.
+func (ts Word) SetTrit(p uint8, t int8) Word {
+	if p >= ts.l || p >= TritsMax {
+		return ts
+	}
+
+	if t > 0 {
+		ts.t1 |= (1 << p)
+		ts.t0 |= (1 << p)
+		return ts
+	}
+	if t < 0 {
+		ts.t1 &^= (1 << p)
+		ts.t0 |= (1 << p)
+		return ts
+	}
+	ts.t1 &^= (1 << p)
+	ts.t0 &^= (1 << p)
+
+	return ts
+}
+
+// Метод установить трит в True
+func (ts Word) SetTrue(p uint8) Word {
+	if p >= ts.l || p >= TritsMax {
+		return ts
+	}
+	ts.t1 |= (1 << p)
+	ts.t0 |= (1 << p)
+	return ts
+}
+
+// Метод установить трит в Nil
+func (ts Word) SetNil(p uint8) Word {
+	if p >= ts.l || p >= TritsMax {
+		return ts
+	}
+	ts.t1 &^= (1 << p)
+	ts.t0 &^= (1 << p)
+	return ts
+}
+
+// Метод установить трит в False
+func (ts Word) SetFalse(p uint8) Word {
+	if p >= ts.l || p >= TritsMax {
+		return ts
+	}
+	ts.t1 &^= (1 << p)
+	ts.t0 |= (1 << p)
+	return ts
+}
+
+// Метод трит в False ?
+func (ts Word) IsFalse(p uint8) bool {
+
+	if (ts.t0 & (1 << p)) == 0 {
+		return false
+	}
+	if (ts.t1 & (1 << p)) == 0 {
+		return true
+	} else {
+		return false
+	}
+}
+
+// Метод трит в Nil ?
+func (ts Word) IsNil(p uint8) bool {
+	if (ts.t0 & (1 << p)) == 0 {
+		return true
+	}
+	return false
+}
+
+// Метод трит в True ?
+func (ts Word) IsTrue(p uint8) bool {
+	if (ts.t0 & (1 << p)) == 0 {
+		return false
+	}
+	if (ts.t1 & (1 << p)) == 0 {
+		return false
+	} else {
+		return true
+	}
+}
+
+// Метод очистить трит
+func (ts Word) Clear(p uint8) Word {
+	if p >= ts.l || p >= TritsMax {
+		return ts
+	}
+	ts.t1 &^= (1 << p)
+	ts.t0 &^= (1 << p)
+	return ts
+}
+
+// Метод вернуть трит в позиции как Trit
+func (ts Word) Trit(p uint8) Trit {
+	return IntToTrit(trs2int(ts, p))
+}
+
+// Метод вернуть символ трита '-','0','+' в позиции
+func (ts Word) Symb(p uint8) string {
+	return trs2symb(ts, p)
+}
+
+// Операция сдвига тритов
+// Версия 1
+// Параметр:
+// if(d > 0) then "Вправо"
+// if(d == 0) then "Нет сдвига"
+// if(d < 0) then "Влево"
+// Возврат: Троичное число
+func shiftTs(tr Word, d int8) Word {
+	if d > 0 {
+		tr.t1 >>= d
+		tr.t0 >>= d
+	} else if d < 0 {
+		tr.t1 <<= -d
+		tr.t0 <<= -d
+	}
+	return tr
+}
+
+// Очистить троичное число и длину
+func (tr *Word) ClearFull() {
+	tr.l = 0
+	tr.t1 = 0
+	tr.t0 = 0
+}
+
+// Очистить троичное число
+func (tr *Word) ClearAll() {
+	tr.t1 = 0
+	tr.t0 = 0
+}
+
+// Наибольший показатель, при котором 3^x помещается в int64
+const Pow3Max = 39
+
+// Степень тройки 3^x для x = 0..Pow3Max
+// При x вне диапазона возникает паника.
+func Pow3(x int8) int64 {
+	if x < 0 || x > Pow3Max {
+		panic("ternary: Pow3 exponent out of range")
+	}
+	var i int8
+	var r int64 = 1
+	for i = 0; i < x; i++ {
+		r *= 3
+	}
+	return r
+}
+
+// Преобразование трита в целое число
+func trs2int(tr Word, p uint8) int8 {
+	if p > TritsMax-1 {
+		p = TritsMax - 1
+	}
+	if (tr.t0 & (1 << p)) == 0 {
+		return 0
+	} else {
+		if (tr.t1 & (1 << p)) == 0 {
+			return -1
+		} else {
+			return 1
+		}
+	}
+}
+
+// Преобразование целое число в трит
+func int2trs(tr Word, p uint8, i int8) Word {
+	if p > TritsMax-1 {
+		p = TritsMax - 1
+	}
+	if i > 0 {
+		tr.t1 |= (1 << p)
+		tr.t0 |= (1 << p)
+		return tr
+	}
+	if i < 0 {
+		tr.t1 &^= (1 << p)
+		tr.t0 |= (1 << p)
+		return tr
+	}
+	tr.t1 &^= (1 << p)
+	tr.t0 &^= (1 << p)
+	return tr
+}
+
+// Преобразовать трит в символ '-','0','+'
+func trs2symb(tr Word, p uint8) string {
+	if p > TritsMax-1 {
+		p = TritsMax - 1
+	}
+	if (tr.t0 & (1 << p)) == 0 {
+		return "0"
+	} else {
+		if (tr.t1 & (1 << p)) == 0 {
+			return "-"
+		} else {
+			return "+"
+		}
+	}
+}
+
+// Операция знак SGN троичного числа
+func (x Word) Sgn() int8 {
+	var i int8
+	if x.l > TritsMax {
+		x.l = TritsMax
+	}
+	for i = int8(x.l) - 1; i >= 0; i -= 1 {
+		if ((x.t0 & (1 << i)) > 0) && ((x.t1 & (1 << i)) == 0) {
+			return -1
+		} else if ((x.t0 & (1 << i)) > 0) && ((x.t1 & (1 << i)) > 0) {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Длина результата двухоперандной операции
+func maxLen(x Word, y Word) uint8 {
+	if x.l > TritsMax {
+		x.l = TritsMax
+	}
+	if y.l > TritsMax {
+		y.l = TritsMax
+	}
+	if x.l >= y.l {
+		return x.l
+	}
+	return y.l
+}
+
+/**
+ * Операция сдвига тритов
+ * Параметр:
+ * if(d > 0) then "Вправо"
+ * if(d == 0) then "Нет сдвига"
+ * if(d < 0) then "Влево"
+ * Возврат: Троичное число
+ */
+func (tr Word) Shift(d int8) Word {
+	if d >= TritsMax || d <= -TritsMax {
+		tr.ClearAll()
+		return tr.norm()
+	}
+	if d > 0 {
+		tr.t1 >>= d
+		tr.t0 >>= d
+	} else if d < 0 {
+		tr.t1 <<= -d
+		tr.t0 <<= -d
+	}
+	return tr.norm()
+}
+
+// Троичное сложение троичных чисел с входным переносом p0
+// (последовательный перенос от трита к триту)
+// sy = +1 сложение, sy = -1 вычитание
+// Возврат: сумма и выходной перенос из старшего трита
+func addTrsSlowly(x Word, y Word, sy int8, p0 int8) (Word, int8) {
+	var i, j uint8
+	var a, b, s, p1 int8
+	var r Word
+
+	j = maxLen(x, y)
+
+	r.l = j
+	r.t0 = 0
+
+	p1 = 0
+
+	for i = 0; i < j; i++ {
+
+		a = trs2int(x, i)
+		b = sy * trs2int(y, i)
+		s, p1 = sumT(a, b, p0)
+		r = int2trs(r, i, s)
+		p0 = p1
+	}
+	return r, p0
+}
+
+// Троичное сложение троичных чисел
+// Перенос из старшего трита отбрасывается.
+func (x Word) Add(y Word) Word {
+	r, _ := addTrs(x, y, 1, 0)
+	return r
+}
+
+// Троичное вычитание троичных чисел
+// Перенос из старшего трита отбрасывается.
+func (x Word) Sub(y Word) Word {
+	r, _ := addTrs(x, y, -1, 0)
+	return r
+}
+
+// Троичное сложение с входным переносом c: x + y + c
+// Возврат: сумма, выходной перенос и признак переполнения
+// (в симметричной системе переполнение равносильно переносу != 0).
+func (x Word) AddCarry(y Word, c Trit) (r Word, carry Trit, overflow bool) {
+	r, p := addTrs(x, y, 1, c.ToInt())
+	return r, IntToTrit(p), p != 0
+}
+
+// Троичное вычитание с входным переносом c: x - y + c
+// Возврат: разность, выходной перенос и признак переполнения.
+func (x Word) SubCarry(y Word, c Trit) (r Word, carry Trit, overflow bool) {
+	r, p := addTrs(x, y, -1, c.ToInt())
+	return r, IntToTrit(p), p != 0
+}
+
+// Сложение по модулю 3^l: перенос из старшего трита отбрасывается
+// Возврат: сумма без переноса и признак переполнения.
+func (x Word) AddWrap(y Word) (Word, bool) {
+	r, p := addTrs(x, y, 1, 0)
+	return r, p != 0
+}
+
+// Вычитание по модулю 3^l: перенос из старшего трита отбрасывается
+// Возврат: разность без переноса и признак переполнения.
+func (x Word) SubWrap(y Word) (Word, bool) {
+	r, p := addTrs(x, y, -1, 0)
+	return r, p != 0
+}
+
+// Заполнить все триты числа значением t
+// (+...+ максимальное, -...- минимальное число длины l)
+func saturate(l uint8, t int8) Word {
+	r := NewWord(l)
+	if t != 0 {
+		r.t0 = mask(r.l)
+	}
+	if t > 0 {
+		r.t1 = r.t0
+	}
+	return r
+}
+
+// Сложение с насыщением
+// Аналог AddSatiation для слова: при переполнении результат
+// ограничивается максимальным (+...+) или минимальным (-...-) числом.
+func (x Word) AddSat(y Word) (Word, bool) {
+	r, p := addTrs(x, y, 1, 0)
+	if p != 0 {
+		return saturate(r.l, p), true
+	}
+	return r, false
+}
+
+// Вычитание с насыщением
+func (x Word) SubSat(y Word) (Word, bool) {
+	r, p := addTrs(x, y, -1, 0)
+	if p != 0 {
+		return saturate(r.l, p), true
+	}
+	return r, false
+}
+
+// Троичное умножение троичных чисел
+// Результат двойной длины: hi — старшая, lo — младшая часть
+// произведения, длина каждой части равна длине большего операнда.
+func (x Word) Mul(y Word) (hi Word, lo Word) {
+	l := maxLen(x, y)
+	var p BigWord
+	p.Mul(new(BigWord).SetWord(x), new(BigWord).SetWord(y))
+	return p.wordAt(int(l), l), p.wordAt(0, l)
+}
+
+// Троичное деление с остатком
+// Делимое двойной длины (hi,lo) делится на y, длина частного и остатка
+// равна длине y. Остаток симметричный: |r| <= |y|/2, (hi,lo) = q*y + r.
+// Если частное не помещается в длину y, старшие триты частного
+// возвращаются в разрядах переполнения ph1, ph2 (как в "Сетунь-1958").
+// Если частное длиннее двух разрядов переполнения, в ph2 записывается
+// знак частного, чтобы переполнение не было потеряно.
+// При y == 0 возникает паника.
+func DivMod(hi Word, lo Word, y Word) (q Word, r Word, ph1 Trit, ph2 Trit) {
+	l := y.l
+	var x, d BigWord
+	x.Lsh(new(BigWord).SetWord(hi), uint(lo.l))
+	x.Add(&x, new(BigWord).SetWord(lo))
+	bq, br := quoRemSym(&x, d.SetWord(y))
+
+	q = bq.wordAt(0, l)
+	r = br.wordAt(0, l)
+	ph1 = IntToTrit(bq.Trit(int(l)))
+	ph2 = IntToTrit(bq.Trit(int(l) + 1))
+	if bq.Len() > int(l)+2 {
+		ph2 = IntToTrit(int8(bq.Sign()))
+	}
+	return q, r, ph1, ph2
+}
@@ -0,0 +1,264 @@
+/**
+ * Filename: 	wordlogic.go
+ *
+ * Project:		Троичная арифметика на языке программирования Golang
+ *
+ * Create date: 18.10.2026
+ * Edit date:   18.10.2026
+ *
+ * Version:		1.02
+ *
+ */
+
+package ternary
+
+// ----------------------------------------------------
+// Поразрядные логические операции над троичными числами
+// ----------------------------------------------------
+
+// Функция одного трита (Not, Neg, ...)
+type TritFunc1 func(a Trit) Trit
+
+// Функция двух тритов (And, Webb, GoedelImplication, ...)
+type TritFunc2 func(a Trit, b Trit) Trit
+
+// Битовые маски тритов числа, дополненного до длины l:
+// p — триты (+), n — триты (-), z — триты (0)
+func (x Word) planes(l uint8) (p uint32, n uint32, z uint32) {
+	x = x.norm()
+	return x.t1, x.t0 &^ x.t1, ^x.t0 & mask(l)
+}
+
+// Троичное число длиной l из масок тритов (+) и (-)
+func fromPlanes(l uint8, p uint32, n uint32) Word {
+	m := mask(l)
+	return Word{l: l, t1: p & m, t0: (p | n) & m}
+}
+
+// Таблица функции одного трита: f(-), f(0), f(+)
+func table1(f TritFunc1) (tbl [3]int8) {
+	for a := int8(-1); a <= 1; a++ {
+		tbl[a+1] = f(IntToTrit(a)).ToInt()
+	}
+	return tbl
+}
+
+// Таблица функции двух тритов: tbl[3*(a+1)+(b+1)] = f(a,b)
+func table2(f TritFunc2) (tbl [9]int8) {
+	for a := int8(-1); a <= 1; a++ {
+		for b := int8(-1); b <= 1; b++ {
+			tbl[3*(a+1)+(b+1)] = f(IntToTrit(a), IntToTrit(b)).ToInt()
+		}
+	}
+	return tbl
+}
+
+// Поразрядно применить функцию трита ко всем тритам числа
+// Функция вычисляется 3 раза, далее результат собирается
+// битовыми операциями над масками тритов.
+func (x Word) Map(f TritFunc1) Word {
+	tbl := table1(f)
+	x = x.norm()
+	xp, xn, xz := x.planes(x.l)
+	cls := [3]uint32{xn, xz, xp}
+	var p, n uint32
+	for i, v := range tbl {
+		switch v {
+		case 1:
+			p |= cls[i]
+		case -1:
+			n |= cls[i]
+		}
+	}
+	return fromPlanes(x.l, p, n)
+}
+
+// Поразрядно применить функцию двух тритов к числам x и y
+// Длина результата равна длине большего операнда,
+// недостающие триты короткого операнда равны 0.
+// Функция вычисляется 9 раз, далее результат собирается
+// битовыми операциями над масками тритов.
+func (x Word) Map2(y Word, f TritFunc2) Word {
+	return x.map2(y, table2(f))
+}
+
+// Поразрядно применить функцию двух тритов, заданную таблицей
+// tbl[3*(a+1)+(b+1)] = f(a,b)
+func (x Word) map2(y Word, tbl [9]int8) Word {
+	l := maxLen(x, y)
+	xp, xn, xz := x.planes(l)
+	yp, yn, yz := y.planes(l)
+	xc := [3]uint32{xn, xz, xp}
+	yc := [3]uint32{yn, yz, yp}
+	var p, n uint32
+	for i, v := range tbl {
+		switch v {
+		case 1:
+			p |= xc[i/3] & yc[i%3]
+		case -1:
+			n |= xc[i/3] & yc[i%3]
+		}
+	}
+	return fromPlanes(l, p, n)
+}
+
+// Поднять функцию трита до поразрядной функции троичных чисел
+func Lift1(f TritFunc1) func(x Word) Word {
+	return func(x Word) Word {
+		return x.Map(f)
+	}
+}
+
+// Поднять функцию двух тритов до поразрядной функции троичных чисел
+func Lift2(f TritFunc2) func(x Word, y Word) Word {
+	return func(x Word, y Word) Word {
+		return x.Map2(y, f)
+	}
+}
+
+// Операция AND trs (Таб.6): MIN(X,Y)
+func (x Word) And(y Word) Word {
+	l := maxLen(x, y)
+	xp, xn, _ := x.planes(l)
+	yp, yn, _ := y.planes(l)
+	return fromPlanes(l, xp&yp, xn|yn)
+}
+
+// Операция OR trs (Таб.7): MAX(X,Y)
+func (x Word) Or(y Word) Word {
+	l := maxLen(x, y)
+	xp, xn, _ := x.planes(l)
+	yp, yn, _ := y.planes(l)
+	return fromPlanes(l, xp|yp, xn&yn)
+}
+
+// Операция XOR trs (Таб.8): -(X*Y)
+func (x Word) Xor(y Word) Word {
+	l := maxLen(x, y)
+	xp, xn, _ := x.planes(l)
+	yp, yn, _ := y.planes(l)
+	return fromPlanes(l, xp&yn|xn&yp, xp&yp|xn&yn)
+}
+
+// Операция NOT trs (Таб.5), совпадает с арифметическим отрицанием
+func (x Word) Not() Word {
+	xp, xn, _ := x.planes(x.l)
+	return fromPlanes(x.l, xn, xp)
+}
+
+// Операция NEG trs (Таб.17)
+func (x Word) Neg() Word {
+	_, xn, xz := x.planes(x.l)
+	return fromPlanes(x.l, xz, xn)
+}
+
+// Консенсус trs: X, если X == Y, иначе 0
+// (поразрядно совпадает с CarryAddMod)
+func (x Word) Consensus(y Word) Word {
+	l := maxLen(x, y)
+	xp, xn, _ := x.planes(l)
+	yp, yn, _ := y.planes(l)
+	return fromPlanes(l, xp&yp, xn&yn)
+}
+
+// Операция EQV trs (Таб.9): X*Y
+func (x Word) Eqv(y Word) Word {
+	l := maxLen(x, y)
+	xp, xn, _ := x.planes(l)
+	yp, yn, _ := y.planes(l)
+	return fromPlanes(l, xp&yp|xn&yn, xp&yn|xn&yp)
+}
+
+// Операция NAND trs (Таб.10): NOT(MIN(X,Y))
+func (x Word) Nand(y Word) Word {
+	l := maxLen(x, y)
+	xp, xn, _ := x.planes(l)
+	yp, yn, _ := y.planes(l)
+	return fromPlanes(l, xn|yn, xp&yp)
+}
+
+// Операция NOR trs (Таб.11): NOT(MAX(X,Y))
+func (x Word) Nor(y Word) Word {
+	l := maxLen(x, y)
+	xp, xn, _ := x.planes(l)
+	yp, yn, _ := y.planes(l)
+	return fromPlanes(l, xn&yn, xp|yp)
+}
+
+// Операция IMP trs (Таб.12): +, если X = -; 0, если X = 0; Y, если X = +
+func (x Word) Imp(y Word) Word {
+	l := maxLen(x, y)
+	xp, xn, _ := x.planes(l)
+	yp, yn, _ := y.planes(l)
+	return fromPlanes(l, xn|xp&yp, xp&yn)
+}
+
+// Операция XMAX trs (Таб.13)
+func (x Word) Xmax(y Word) Word { return x.Map2(y, Xmax) }
+
+// Операция IXMAX trs (Таб.14)
+func (x Word) Ixmax(y Word) Word { return x.Map2(y, Ixmax) }
+
+// Операция MEAN trs (Таб.15)
+func (x Word) Mean(y Word) Word { return x.Map2(y, Mean) }
+
+// Операция Magnitude trs (Таб.16)
+func (x Word) Magnitude(y Word) Word { return x.Map2(y, Magnitude) }
+
+// Поразрядное сложение по модулю (без переноса)
+func (x Word) AddMod(y Word) Word { return x.Map2(y, AddMod) }
+
+// Поразрядный перенос в сложении по модулю
+func (x Word) CarryAddMod(y Word) Word { return x.Consensus(y) }
+
+// Поразрядное сложение с насыщением
+func (x Word) AddSatiation(y Word) Word { return x.Map2(y, AddSatiation) }
+
+// Поразрядная функция Webb
+func (x Word) Webb(y Word) Word { return x.Map2(y, Webb) }
+
+// Поразрядное тождество (строгое)
+func (x Word) IdentityStrict(y Word) Word { return x.Map2(y, IdentityStrict) }
+
+// Поразрядное тождество (weak)
+func (x Word) Weak(y Word) Word { return x.Map2(y, Weak) }
+
+// Поразрядная коньюнкция Лукашевича (сильная)
+func (x Word) ConjunctionLukashevichStrong(y Word) Word {
+	return x.Map2(y, ConjunctionLukashevichStrong)
+}
+
+// Поразрядная импликация Лукашевича
+func (x Word) LukashevichImplication(y Word) Word {
+	l := maxLen(x, y)
+	xp, xn, xz := x.planes(l)
+	yp, yn, _ := y.planes(l)
+	return fromPlanes(l, xp|xz&^yp|xn&yn, xn&yp)
+}
+
+// Поразрядная коньюнкция Клини
+func (x Word) KleeneConjunction(y Word) Word { return x.Map2(y, KleeneConjunction) }
+
+// Поразрядная импликация Клини
+func (x Word) KleeneImplication(y Word) Word {
+	return x.Imp(y)
+}
+
+// Поразрядная интуиционистская импликация Геделя
+func (x Word) GoedelImplication(y Word) Word {
+	l := maxLen(x, y)
+	xp, xn, xz := x.planes(l)
+	_, yn, yz := y.planes(l)
+	return fromPlanes(l, xp|yn|xz&yz, xn&^yn)
+}
+
+// Поразрядная материальная импликация
+func (x Word) MaterialImplication(y Word) Word {
+	l := maxLen(x, y)
+	xp, xn, _ := x.planes(l)
+	yp, yn, _ := y.planes(l)
+	return fromPlanes(l, xp|yn, xn&yp)
+}
+
+// Поразрядная функция следования Брусенцова
+func (x Word) FollowingBrusentsov(y Word) Word { return x.Map2(y, FollowingBrusentsov) }
@@ -0,0 +1,138 @@
+package ternary
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// Случайное троичное число длиной l (для тестов)
+func randWord(rnd *rand.Rand, l uint8) Word {
+	x := NewWord(l)
+	for i := uint8(0); i < l; i++ {
+		x = x.SetTrit(i, int8(rnd.Intn(3)-1))
+	}
+	return x
+}
+
+// Поразрядная операция циклом по тритам (для сравнения в тестах)
+func map2Slowly(x Word, y Word, f TritFunc2) Word {
+	r := NewWord(maxLen(x, y))
+	for i := uint8(0); i < r.l; i++ {
+		r = int2trs(r, i, f(IntToTrit(trs2int(x, i)), IntToTrit(trs2int(y, i))).ToInt())
+	}
+	return r
+}
+
+func Test_word_logic(t *testing.T) {
+	ops := map[string]struct {
+		w func(Word, Word) Word
+		f TritFunc2
+	}{
+		"and":    {Word.And, And},
+		"or":     {Word.Or, Or},
+		"xor":    {Word.Xor, Xor},
+		"eqv":    {Word.Eqv, Eqv},
+		"nand":   {Word.Nand, Nand},
+		"nor":    {Word.Nor, Nor},
+		"imp":    {Word.Imp, Imp},
+		"xmax":   {Word.Xmax, Xmax},
+		"ixmax":  {Word.Ixmax, Ixmax},
+		"mean":   {Word.Mean, Mean},
+		"magn":   {Word.Magnitude, Magnitude},
+		"addmod": {Word.AddMod, AddMod},
+		"carry":  {Word.CarryAddMod, CarryAddMod},
+		"cons":   {Word.Consensus, CarryAddMod},
+		"sat":    {Word.AddSatiation, AddSatiation},
+		"webb":   {Word.Webb, Webb},
+		"ident":  {Word.IdentityStrict, IdentityStrict},
+		"weak":   {Word.Weak, Weak},
+		"lconj":  {Word.ConjunctionLukashevichStrong, ConjunctionLukashevichStrong},
+		"limp":   {Word.LukashevichImplication, LukashevichImplication},
+		"kconj":  {Word.KleeneConjunction, KleeneConjunction},
+		"kimp":   {Word.KleeneImplication, KleeneImplication},
+		"gimp":   {Word.GoedelImplication, GoedelImplication},
+		"mimp":   {Word.MaterialImplication, MaterialImplication},
+		"brus":   {Word.FollowingBrusentsov, FollowingBrusentsov},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		x, y := randWord(rnd, TritsMax), randWord(rnd, TritsMax)
+		for name, op := range ops {
+			r := op.w(x, y)
+			if r != map2Slowly(x, y, op.f) {
+				t.Fatalf("%s(%v, %v) = %v", name, x, y, r)
+			}
+			if r.Len() != TritsMax {
+				t.Fatalf("%s: length %d", name, r.Len())
+			}
+			for p := uint8(0); p < TritsMax; p++ {
+				if r.Trit(p) != op.f(x.Trit(p), y.Trit(p)) {
+					t.Fatalf("%s(%v, %v) = %v: trit %d", name, x, y, r, p)
+				}
+			}
+		}
+		for p := uint8(0); p < TritsMax; p++ {
+			if x.Not().Trit(p) != Not(x.Trit(p)) || x.Neg().Trit(p) != Neg(x.Trit(p)) {
+				t.Fatalf("not/neg(%v): trit %d", x, p)
+			}
+		}
+		if x.Not().Int64() != -x.Int64() {
+			t.Fatalf("not(%v) != -x", x)
+		}
+	}
+	webb := Lift2(Webb)
+	if x, y := randWord(rnd, 5), randWord(rnd, 9); webb(x, y).Len() != 9 {
+		t.Errorf("Lift2: length %d", webb(x, y).Len())
+	}
+}
+
+func Test_word_logic_len(t *testing.T) {
+	x, _ := ParseWord("+-", AlphabetTrit)
+	y, _ := ParseWord("-0+0-", AlphabetTrit)
+	if r := x.And(y); r.String() != "-000-" {
+		t.Errorf("and_trs(%v, %v) = %v", x, y, r)
+	}
+	if r := x.Neg(); r.String() != "0-" {
+		t.Errorf("neg_trs(%v) = %v", x, r)
+	}
+}
+
+func Benchmark_and_trs(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	x, y := randWord(rnd, TritsMax), randWord(rnd, TritsMax)
+	for i := 0; i < b.N; i++ {
+		x.And(y)
+	}
+}
+
+func Benchmark_and_trs_slowly(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	x, y := randWord(rnd, TritsMax), randWord(rnd, TritsMax)
+	for i := 0; i < b.N; i++ {
+		map2Slowly(x, y, And)
+	}
+}
+
+func Benchmark_xor_trs(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	x, y := randWord(rnd, TritsMax), randWord(rnd, TritsMax)
+	for i := 0; i < b.N; i++ {
+		x.Xor(y)
+	}
+}
+
+func Benchmark_goedel_trs(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	x, y := randWord(rnd, TritsMax), randWord(rnd, TritsMax)
+	for i := 0; i < b.N; i++ {
+		x.GoedelImplication(y)
+	}
+}
+
+func Benchmark_map2_trs(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	x, y := randWord(rnd, TritsMax), randWord(rnd, TritsMax)
+	for i := 0; i < b.N; i++ {
+		x.Map2(y, Webb)
+	}
+}